@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+
+# List the alert policies of a team
+data "atlassian-operations_alert_policies" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# List the global alert policies whose name starts with "Terraform"
+data "atlassian-operations_alert_policies" "global" {
+  name_regex = "^Terraform"
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Returns the user the provider is authenticated as
+# organization_id is only required for Compass
+data "atlassian-operations_current_user" "me" {
+  organization_id = "your-organization-id"
+}
+
+output "my_account_id" {
+  value = data.atlassian-operations_current_user.me.account_id
+}
@@ -0,0 +1,13 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+
+# Look up an Atlassian Operations Custom Role by name
+data "atlassian-operations_custom_role" "example" {
+  name = "Incident Commander"
+}
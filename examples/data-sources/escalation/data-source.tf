@@ -0,0 +1,13 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Get Atlassian Operations Escalation by name within a team
+data "atlassian-operations_escalation" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name    = "Test escalation"
+}
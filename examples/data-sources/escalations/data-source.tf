@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# List all Atlassian Operations Escalations of a team
+data "atlassian-operations_escalations" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
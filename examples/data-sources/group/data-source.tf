@@ -0,0 +1,13 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+
+# Look up a Jira group by name
+data "atlassian-operations_group" "example" {
+  name = "jira-servicemanagement-users"
+}
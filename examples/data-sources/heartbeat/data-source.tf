@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+
+# Look up an Atlassian Operations Heartbeat of a team
+data "atlassian-operations_heartbeat" "example" {
+  name    = "payments-api"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Fail the plan when the heartbeat is expired
+check "payments_api_heartbeat" {
+  assert {
+    condition     = !data.atlassian-operations_heartbeat.example.expired
+    error_message = "The payments-api heartbeat is expired."
+  }
+}
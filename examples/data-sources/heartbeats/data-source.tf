@@ -0,0 +1,13 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+
+# List the heartbeats of a team
+data "atlassian-operations_heartbeats" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+
+# Look up an Atlassian Operations Integration by name
+data "atlassian-operations_integration" "example" {
+  name    = "My API Integration"
+  type    = "API"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
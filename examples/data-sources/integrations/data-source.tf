@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+
+# List the enabled Atlassian Operations API Integrations of a team
+data "atlassian-operations_integrations" "example" {
+  type    = "API"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  enabled = true
+}
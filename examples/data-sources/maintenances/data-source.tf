@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+
+# List the upcoming and ongoing maintenance windows of a team affecting an integration
+data "atlassian-operations_maintenances" "example" {
+  type      = "non-expired"
+  team_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  entity_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
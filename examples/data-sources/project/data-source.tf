@@ -0,0 +1,13 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+
+# Look up a Jira project by key
+data "atlassian-operations_project" "example" {
+  key = "OPS"
+}
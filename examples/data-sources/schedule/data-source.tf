@@ -10,3 +10,15 @@ terraform {
 data "atlassian-operations_schedule" "example" {
   name = "Test schedule"
 }
+
+# Get Atlassian Operations Schedule by name, ignoring case, within a team
+data "atlassian-operations_schedule" "example_team" {
+  name        = "test schedule"
+  ignore_case = true
+  team_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Get Atlassian Operations Schedule by ID
+data "atlassian-operations_schedule" "example_id" {
+  id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# List all enabled schedules of a team
+data "atlassian-operations_schedules" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  enabled = true
+}
+
+# List all schedules whose name starts with "payments"
+data "atlassian-operations_schedules" "payments" {
+  name_regex = "^payments"
+}
+
+# Iterate over the listed schedules
+output "schedule_ids" {
+  value = { for schedule in data.atlassian-operations_schedules.payments.schedules : schedule.name => schedule.id }
+}
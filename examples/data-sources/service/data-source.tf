@@ -0,0 +1,13 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+
+# Look up a JSM Service by name
+data "atlassian-operations_service" "example" {
+  name = "My Service"
+}
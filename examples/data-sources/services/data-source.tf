@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+
+# List the tier 1 software services owned by a team
+data "atlassian-operations_services" "example" {
+  tier  = 1
+  type  = "SOFTWARE_SERVICES"
+  owner = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+# Returns the enabled email contacts of the user the provider is authenticated as
+data "atlassian-operations_user_contacts" "email" {
+  method  = "email"
+  enabled = true
+}
+
+output "email_destinations" {
+  value = [for contact in data.atlassian-operations_user_contacts.email.contacts : contact.to]
+}
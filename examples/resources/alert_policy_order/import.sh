@@ -0,0 +1,3 @@
+# Alert Policy Order can be imported by providing the team id, or "global" for the global alert policies
+terraform import atlassian-operations_alert_policy_order.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+terraform import atlassian-operations_alert_policy_order.global "global"
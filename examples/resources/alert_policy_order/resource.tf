@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_alert_policy" "critical" {
+  name    = "Raise priority of database alerts"
+  type    = "alert"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  enabled = true
+  message = "{{message}}"
+}
+
+resource "atlassian-operations_alert_policy" "tagging" {
+  name    = "Tag alerts of the team"
+  type    = "alert"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  enabled = true
+  message = "{{message}}"
+  tags    = ["team"]
+}
+
+resource "atlassian-operations_alert_policy_order" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  policy_ids = [
+    atlassian-operations_alert_policy.critical.id,
+    atlassian-operations_alert_policy.tagging.id,
+  ]
+}
+
+# The global alert policies are ordered when team_id is omitted
+resource "atlassian-operations_alert_policy_order" "global" {
+  policy_ids = [
+    "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+    "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+  ]
+}
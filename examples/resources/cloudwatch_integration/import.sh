@@ -0,0 +1,2 @@
+# CloudWatchIntegration can be imported by providing the integration id
+terraform import atlassian-operations_cloudwatch_integration.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
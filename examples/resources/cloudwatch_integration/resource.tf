@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_cloudwatch_integration" "example" {
+  name    = "CloudWatch integration"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  type_specific_properties = {
+    aws_region = "us-east-1"
+  }
+}
+
+# Subscribe the integration to the SNS topic the CloudWatch alarms publish to
+resource "aws_sns_topic_subscription" "alarms" {
+  topic_arn              = "arn:aws:sns:us-east-1:123456789012:cloudwatch-alarms"
+  protocol               = "https"
+  endpoint               = atlassian-operations_cloudwatch_integration.example.webhook_url
+  endpoint_auto_confirms = true
+}
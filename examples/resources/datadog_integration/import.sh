@@ -0,0 +1,2 @@
+# DatadogIntegration can be imported by providing the integration id
+terraform import atlassian-operations_datadog_integration.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
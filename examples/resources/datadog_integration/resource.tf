@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_datadog_integration" "example" {
+  name    = "Datadog integration"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  type_specific_properties = {
+    datadog_site            = "EU"
+    close_alert_on_recovery = true
+  }
+}
+
+# Use as the url of a Datadog webhook
+output "datadog_webhook_url" {
+  value     = atlassian-operations_datadog_integration.example.webhook_url
+  sensitive = true
+}
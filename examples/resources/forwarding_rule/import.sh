@@ -0,0 +1,2 @@
+# Forwarding Rule can be imported by providing the forwarding rule id
+terraform import atlassian-operations_forwarding_rule.vacation "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
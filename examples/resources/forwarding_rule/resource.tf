@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+data "atlassian-operations_user" "on_vacation" {
+  email_address   = "user1@example.com"
+  organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+data "atlassian-operations_user" "colleague" {
+  email_address   = "user2@example.com"
+  organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+resource "atlassian-operations_forwarding_rule" "vacation" {
+  alias        = "summer-vacation"
+  from_user_id = data.atlassian-operations_user.on_vacation.account_id
+  to = {
+    type = "user"
+    id   = data.atlassian-operations_user.colleague.account_id
+  }
+  start_date = "2030-07-01T00:00:00Z"
+  end_date   = "2030-07-15T00:00:00Z"
+}
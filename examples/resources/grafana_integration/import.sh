@@ -0,0 +1,2 @@
+# GrafanaIntegration can be imported by providing the integration id
+terraform import atlassian-operations_grafana_integration.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_grafana_integration" "example" {
+  name    = "Grafana integration"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  type_specific_properties = {
+    grafana_url = "https://grafana.example.com"
+  }
+}
+
+# Use as the url of a Grafana webhook contact point
+output "grafana_webhook_url" {
+  value     = atlassian-operations_grafana_integration.example.webhook_url
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Incoming Call Routing can be imported by providing the incoming call routing id and the team id, separated by a comma
+terraform import atlassian-operations_incoming_call_routing.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_incoming_call_routing" "example" {
+  team_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name     = "Support hotline"
+  greeting = "Thank you for calling the on-call team."
+
+  routing_rules = [
+    {
+      name = "Business hours"
+      time_restriction = {
+        type = "time-of-day"
+        restriction = {
+          start_hour = 9
+          end_hour   = 17
+          start_min  = 0
+          end_min    = 0
+        }
+      }
+      recipient = {
+        type = "schedule"
+        id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+      }
+    },
+    {
+      name = "Out of hours"
+      recipient = {
+        type = "escalation"
+        id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+      }
+    }
+  ]
+
+  fallback = {
+    type = "voicemail"
+  }
+}
@@ -22,4 +22,27 @@ resource "atlassian-operations_maintenance" "example" {
     }
   }
   ]
-} 
\ No newline at end of file
+} 
+# This example demonstrates a weekly maintenance window. The next occurrences are created as maintenance windows
+# and rolled forward on every apply.
+resource "atlassian-operations_maintenance" "weekly" {
+  description = "Weekly patch window"
+
+  recurrence = {
+    frequency            = "weekly"
+    days_of_week         = ["sunday"]
+    start_time           = "02:00"
+    duration_minutes     = 120
+    timezone             = "Europe/London"
+    until                = "2030-12-31T23:59:59Z"
+    upcoming_occurrences = 4
+  }
+
+  rules = [{
+    state = "disabled"
+    entity = {
+      id   = "integration-1234" # Replace with your integration ID
+      type = "integration"
+    }
+  }]
+}
@@ -0,0 +1,2 @@
+# MicrosoftTeamsIntegration can be imported by providing the integration id
+terraform import atlassian-operations_microsoft_teams_integration.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
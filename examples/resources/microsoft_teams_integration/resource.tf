@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_microsoft_teams_integration" "example" {
+  name    = "Microsoft Teams integration"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  type_specific_properties = {
+    workspace     = "Operations"
+    channel       = "Alerts"
+    alert_actions = ["Create", "Acknowledge", "Close"]
+    # Only forward P1 and P2 alerts
+    filter = {
+      type = "match-any-condition"
+      conditions = [
+        {
+          field          = "priority"
+          operation      = "equals"
+          expected_value = "P1"
+        },
+        {
+          field          = "priority"
+          operation      = "equals"
+          expected_value = "P2"
+        }
+      ]
+    }
+  }
+}
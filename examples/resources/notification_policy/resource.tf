@@ -14,7 +14,6 @@ resource "atlassian-operations_notification_policy" "advanced" {
   team_id     = "3b7188be-91ff-40e8-8952-b0a83c7dfc58"
   type = "notification"
   enabled     = true
-  order       = 0.0
 
   filter = {
     type = "match-all-conditions"
@@ -0,0 +1,2 @@
+# Notification Policy Order can be imported by providing the team id
+terraform import atlassian-operations_notification_policy_order.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
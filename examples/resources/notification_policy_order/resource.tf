@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_notification_policy" "business_hours" {
+  name    = "Suppress notifications outside business hours"
+  type    = "notification"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  enabled = true
+}
+
+resource "atlassian-operations_notification_policy" "delay" {
+  name    = "Delay notifications of low priority alerts"
+  type    = "notification"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  enabled = true
+}
+
+resource "atlassian-operations_notification_policy_order" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  policy_ids = [
+    atlassian-operations_notification_policy.business_hours.id,
+    atlassian-operations_notification_policy.delay.id,
+  ]
+}
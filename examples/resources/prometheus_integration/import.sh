@@ -0,0 +1,2 @@
+# PrometheusIntegration can be imported by providing the integration id
+terraform import atlassian-operations_prometheus_integration.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
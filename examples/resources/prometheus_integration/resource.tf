@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_prometheus_integration" "example" {
+  name    = "Prometheus integration"
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  type_specific_properties = {
+    close_alert_on_resolve = true
+  }
+}
+
+# Use as the url of an Alertmanager webhook receiver
+output "alertmanager_webhook_url" {
+  value     = atlassian-operations_prometheus_integration.example.webhook_url
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Routing Rule Order can be imported by providing the team id, which imports the order of all routing rules of the team
+terraform import atlassian-operations_routing_rule_order.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
@@ -0,0 +1,60 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_routing_rule" "business_hours" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name    = "Business hours"
+
+  criteria = {
+    type = "match-all"
+  }
+
+  time_restriction = {
+    type = "time-of-day"
+    restriction = {
+      start_hour = 9
+      end_hour   = 17
+      start_min  = 0
+      end_min    = 0
+    }
+  }
+
+  notify = {
+    type = "schedule"
+    id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+}
+
+resource "atlassian-operations_routing_rule" "critical" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name    = "Critical alerts"
+
+  criteria = {
+    type = "match-all-conditions"
+    conditions = [
+      {
+        field          = "priority"
+        operation      = "equals"
+        expected_value = "P1"
+      }
+    ]
+  }
+
+  notify = {
+    type = "escalation"
+    id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+}
+
+resource "atlassian-operations_routing_rule_order" "example" {
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  rule_ids = [
+    atlassian-operations_routing_rule.critical.id,
+    atlassian-operations_routing_rule.business_hours.id,
+  ]
+}
@@ -0,0 +1,2 @@
+# Schedule Override can be imported by providing the override alias and the schedule id, seperated by a comma
+terraform import atlassian-operations_schedule_override.example "holiday-cover,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
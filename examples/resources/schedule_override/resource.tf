@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_schedule_override" "example" {
+  schedule_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  alias       = "holiday-cover"
+  start_date  = "2030-12-24T09:00:00Z"
+  end_date    = "2030-12-27T09:00:00Z"
+  responder = {
+    id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    type = "user"
+  }
+  rotation_ids = ["xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"]
+}
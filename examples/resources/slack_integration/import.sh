@@ -0,0 +1,2 @@
+# SlackIntegration can be imported by providing the integration id
+terraform import atlassian-operations_slack_integration.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
@@ -0,0 +1,2 @@
+# Team Default Routing Rule can be imported by providing the team id
+terraform import atlassian-operations_team_default_routing_rule.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
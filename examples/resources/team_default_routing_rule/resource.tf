@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  display_name    = "Example Team"
+  description     = "Team with a configured default routing rule"
+  team_type       = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = "712020:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    }
+  ]
+}
+
+resource "atlassian-operations_team_default_routing_rule" "example" {
+  team_id = atlassian-operations_team.example.id
+  name    = "Route everything else"
+  notify = {
+    type = "escalation"
+    id   = atlassian-operations_team.example.default_escalation_id
+  }
+}
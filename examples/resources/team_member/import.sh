@@ -0,0 +1,2 @@
+# Team Member can be imported by its id, which is the team id, the account id and the organization id, separated by commas
+terraform import atlassian-operations_team_member.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,712020:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  display_name    = "Example Team"
+  description     = "Members are managed with atlassian-operations_team_member"
+  team_type       = "MEMBER_INVITE"
+  manage_members  = false
+}
+
+resource "atlassian-operations_team_member" "example" {
+  organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  team_id         = atlassian-operations_team.example.id
+  account_id      = "712020:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  role            = "admin"
+}
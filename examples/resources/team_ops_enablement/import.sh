@@ -0,0 +1,2 @@
+# Team Ops Enablement can be imported by providing the team id and the organization id, seperated by a comma
+terraform import atlassian-operations_team_ops_enablement.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
@@ -0,0 +1,2 @@
+# User Role Assignment can be imported by providing the account id of the user
+terraform import atlassian-operations_user_role_assignment.example "712020:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+data "atlassian-operations_user" "example" {
+  email_address   = "user@example.com"
+  organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+resource "atlassian-operations_custom_role" "responder" {
+  name = "Responder"
+  granted_rights = [
+    "alert-acknowledge",
+    "alert-close",
+  ]
+  disallowed_rights = [
+    "alert-delete",
+  ]
+}
+
+resource "atlassian-operations_user_role_assignment" "example" {
+  account_id = data.atlassian-operations_user.example.account_id
+  role       = atlassian-operations_custom_role.responder.id
+}
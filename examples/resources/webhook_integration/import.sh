@@ -0,0 +1,2 @@
+# WebhookIntegration can be imported by providing the integration id
+terraform import atlassian-operations_webhook_integration.example "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    atlassian-operations = {
+      source = "registry.terraform.io/atlassian/atlassian-operations"
+    }
+  }
+}
+
+resource "atlassian-operations_webhook_integration" "example" {
+  name    = "webhook integration"
+  enabled = true
+  team_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  type_specific_properties = {
+    url = "https://example.com/hooks/alerts"
+    headers = {
+      "Authorization" = "Bearer xxxxx"
+    }
+    add_alert_description = true
+    add_alert_details     = false
+  }
+}
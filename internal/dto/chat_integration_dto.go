@@ -0,0 +1,8 @@
+package dto
+
+type ChatIntegrationTypeSpecificPropertiesDto struct {
+	Workspace    string       `json:"workspace"`
+	Channel      string       `json:"channel"`
+	AlertActions []string     `json:"alertActions"`
+	AlertFilter  *CriteriaDto `json:"alertFilter,omitempty"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+// ForwardingRuleDto represents a rule that forwards the notifications of a user to another user or a team
+type ForwardingRuleDto struct {
+	ID        string        `json:"id,omitempty"`
+	Alias     string        `json:"alias,omitempty"`
+	FromUser  ResponderInfo `json:"fromUser"`
+	To        ResponderInfo `json:"to"`
+	StartDate string        `json:"startDate"`
+	EndDate   string        `json:"endDate,omitempty"`
+}
@@ -7,6 +7,8 @@ type HeartbeatDto struct {
 	IntervalUnit  string   `json:"intervalUnit"`
 	Enabled       bool     `json:"enabled"`
 	Status        string   `json:"status,omitempty"`
+	LastPingTime  string   `json:"lastPingTime,omitempty"`
+	Expired       bool     `json:"expired,omitempty"`
 	OwnerTeamId   string   `json:"ownerTeamId,omitempty"`
 	AlertMessage  string   `json:"alertMessage,omitempty"`
 	AlertTags     []string `json:"alertTags,omitempty"`
@@ -0,0 +1,24 @@
+package dto
+
+// IncomingCallRoutingDto represents the routing of the phone calls made to a number of a team
+type IncomingCallRoutingDto struct {
+	ID           string                       `json:"id,omitempty"`
+	Name         string                       `json:"name"`
+	PhoneNumber  string                       `json:"phoneNumber,omitempty"`
+	Greeting     string                       `json:"greeting,omitempty"`
+	RoutingRules []IncomingCallRoutingRuleDto `json:"routingRules"`
+	Fallback     *IncomingCallRecipientDto    `json:"fallback,omitempty"`
+}
+
+// IncomingCallRoutingRuleDto represents a rule that routes the calls received in a time window to a recipient
+type IncomingCallRoutingRuleDto struct {
+	Name            string                   `json:"name,omitempty"`
+	TimeRestriction *TimeRestriction         `json:"timeRestriction,omitempty"`
+	Recipient       IncomingCallRecipientDto `json:"recipient"`
+}
+
+// IncomingCallRecipientDto represents the team, escalation or schedule a call is routed to
+type IncomingCallRecipientDto struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+}
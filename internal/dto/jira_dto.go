@@ -0,0 +1,21 @@
+package dto
+
+type (
+	GroupPickerResponseDto struct {
+		Header string                `json:"header"`
+		Total  int32                 `json:"total"`
+		Groups []GroupPickerGroupDto `json:"groups"`
+	}
+	GroupPickerGroupDto struct {
+		GroupId string `json:"groupId"`
+		Name    string `json:"name"`
+	}
+	ProjectDto struct {
+		Id             string `json:"id"`
+		Key            string `json:"key"`
+		Name           string `json:"name"`
+		Description    string `json:"description"`
+		ProjectTypeKey string `json:"projectTypeKey"`
+		Self           string `json:"self"`
+	}
+)
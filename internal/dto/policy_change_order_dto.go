@@ -0,0 +1,5 @@
+package dto
+
+type PolicyChangeOrderDto struct {
+	TargetIndex int64 `json:"targetIndex"`
+}
@@ -41,3 +41,7 @@ type RoutingRuleNotifyDto struct {
 	Type string `json:"type"`
 	ID   string `json:"id"`
 }
+
+type RoutingRuleChangeOrderDto struct {
+	Order int64 `json:"order"`
+}
@@ -2,12 +2,13 @@ package dto
 
 type (
 	Schedule struct {
-		Id          string `json:"id"`
-		Name        string `json:"name"`
-		Description string `json:"description"`
-		Timezone    string `json:"timezone"`
-		Enabled     bool   `json:"enabled"`
-		TeamId      string `json:"teamId"`
+		Id          string     `json:"id"`
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		Timezone    string     `json:"timezone"`
+		Enabled     bool       `json:"enabled"`
+		TeamId      string     `json:"teamId"`
+		Rotations   []Rotation `json:"rotations,omitempty"`
 	}
 
 	ListSchedule struct {
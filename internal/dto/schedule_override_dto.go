@@ -0,0 +1,14 @@
+package dto
+
+type ScheduleOverrideRotation struct {
+	Id   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+type ScheduleOverride struct {
+	Alias     string                     `json:"alias,omitempty"`
+	User      ResponderInfo              `json:"user"`
+	StartDate string                     `json:"startDate"`
+	EndDate   string                     `json:"endDate"`
+	Rotations []ScheduleOverrideRotation `json:"rotations"`
+}
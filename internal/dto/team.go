@@ -29,6 +29,9 @@ type (
 	TeamMemberList struct {
 		Members []TeamMember `json:"members"`
 	}
+	TeamMemberRole struct {
+		Role string `json:"role"`
+	}
 	TeamEnableOps struct {
 		TeamId          string   `json:"platformTeamId"`
 		AdminAccountIds []string `json:"adminAccountIds"`
@@ -64,6 +64,10 @@ type (
 		Data []OrgUserDto `json:"data"`
 	}
 
+	UserRoleDto struct {
+		Role string `json:"role"`
+	}
+
 	OrgUserDto struct {
 		AccountId     string        `json:"accountId"`
 		AccountType   AccountType   `json:"accountType"`
@@ -0,0 +1,22 @@
+package dto
+
+type (
+	WebhookIntegrationTypeSpecificPropertiesDto struct {
+		Url                 string            `json:"url"`
+		Headers             map[string]string `json:"headers"`
+		AddAlertDescription bool              `json:"addAlertDescription"`
+		AddAlertDetails     bool              `json:"addAlertDetails"`
+	}
+	WebhookIntegration struct {
+		Id                     string                                      `json:"id"`
+		Name                   string                                      `json:"name"`
+		Type                   string                                      `json:"type"`
+		TeamId                 string                                      `json:"teamId"`
+		Enabled                bool                                        `json:"enabled"`
+		Advanced               bool                                        `json:"advanced,omitempty"`
+		MaintenanceSources     []MaintenanceSource                         `json:"maintenanceSources,omitempty"`
+		Directions             []string                                    `json:"directions,omitempty"`
+		Domains                []string                                    `json:"domains,omitempty"`
+		TypeSpecificProperties WebhookIntegrationTypeSpecificPropertiesDto `json:"typeSpecificProperties"`
+	}
+)
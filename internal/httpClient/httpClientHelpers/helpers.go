@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"net/url"
 )
 
 func GenerateJsmOpsClientRequest(providerModel dto.AtlassianOpsProviderModel) *httpClient.Request {
@@ -47,7 +48,7 @@ func GenerateUserClientRequest(providerModel dto.AtlassianOpsProviderModel) *htt
 	req := httpClient.NewRequest()
 	switch providerModel.GetProductType() {
 	case "jira-service-desk":
-		req.SetUrl(fmt.Sprintf("https://%s/rest/api/3/user/", providerModel.GetDomainName()))
+		req.SetUrl(fmt.Sprintf("%s/user/", getJiraApiUrl(providerModel.GetDomainName())))
 		req.SetBasicAuth(providerModel.GetEmailAddress(), providerModel.GetToken())
 	default:
 		req.SetUrl(fmt.Sprintf("%s/admin/v2/orgs/", getAtlassianApiDomain(providerModel.GetIsStaging())))
@@ -60,9 +61,53 @@ func GenerateUserClientRequest(providerModel dto.AtlassianOpsProviderModel) *htt
 	return req
 }
 
+// GenerateJiraClientRequest returns a request to the Jira REST API of the site, authenticated the same way as the
+// user lookups of Jira Service Management sites.
+func GenerateJiraClientRequest(providerModel dto.AtlassianOpsProviderModel) *httpClient.Request {
+	req := httpClient.NewRequest()
+	req.SetUrl(getJiraApiUrl(providerModel.GetDomainName()))
+	req.SetRetryCount(providerModel.GetApiRetryCount())
+	req.SetRetryWaitTime(providerModel.GetApiRetryWait())
+	req.SetRetryMaxWaitTime(providerModel.GetApiRetryWaitMax())
+	req.SetBasicAuth(providerModel.GetEmailAddress(), providerModel.GetToken())
+	return req
+}
+
+// GetIntegrationWebhookUrl returns the URL monitoring tools post their events to for the given integration endpoint.
+// This is the documented integration endpoint of the Atlassian API gateway, which serves every region since the API
+// key identifies the site. The key is passed as a query parameter, as most tools do not support custom headers.
+func GetIntegrationWebhookUrl(providerModel dto.AtlassianOpsProviderModel, endpoint string, apiKey string) string {
+	return fmt.Sprintf(
+		"%s/jsm/ops/integration/v1/json/integrations/webhooks/%s?apiKey=%s",
+		getAtlassianApiDomain(providerModel.GetIsStaging()),
+		endpoint,
+		url.QueryEscape(apiKey),
+	)
+}
+
+func getJiraApiUrl(domainName string) string {
+	return fmt.Sprintf("https://%s/rest/api/3", domainName)
+}
+
 func getAtlassianApiDomain(isStaging bool) string {
 	if isStaging {
 		return "https://api.stg.atlassian.com"
 	}
 	return "https://api.atlassian.com"
 }
+
+// ParseNextPageQueryParams extracts the query parameters from the "links.next" URL returned by the paginated
+// list endpoints, so that the next page can be requested with the same base URL.
+func ParseNextPageQueryParams(nextUrl string) (map[string]string, error) {
+	parsedUrl, err := url.Parse(nextUrl)
+	if err != nil {
+		return nil, err
+	}
+	queryParams := make(map[string]string)
+	for key, values := range parsedUrl.Query() {
+		if len(values) > 0 {
+			queryParams[key] = values[0]
+		}
+	}
+	return queryParams, nil
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &AlertPoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &AlertPoliciesDataSource{}
+)
+
+func NewAlertPoliciesDataSource() datasource.DataSource {
+	return &AlertPoliciesDataSource{}
+}
+
+// AlertPoliciesDataSource defines the data source implementation.
+type AlertPoliciesDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *AlertPoliciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_policies"
+}
+
+func (d *AlertPoliciesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Alert policies data source",
+		Attributes:          schemaAttributes.AlertPoliciesDataSourceAttributes,
+	}
+}
+
+func (d *AlertPoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring alert_policies_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure alert_policies_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured alert_policies_data_source")
+}
+
+func (d *AlertPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.AlertPoliciesDataSourceModel
+	var nameRegex *regexp.Regexp
+
+	tflog.Trace(ctx, "Reading alert policies data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read alert policies configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	if !model.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(model.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Unable to compile name_regex, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	// Global alert policies have their own endpoint, team endpoints list every type of policy
+	endpoint := "/v1/alerts/policies"
+	queryParams := map[string]string{}
+	if model.TeamID.ValueString() != "" {
+		endpoint = fmt.Sprintf("/v1/teams/%s/policies", model.TeamID.ValueString())
+		queryParams["type"] = "alert"
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	policies := listAllPages[dto.BaseAlertPolicyDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(d.clientConfiguration)
+		},
+		endpoint,
+		queryParams,
+		"list alert policies",
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP requests to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+
+	policyValues := make([]attr.Value, 0)
+	for _, policy := range policies {
+		if nameRegex != nil && !nameRegex.MatchString(policy.Name) {
+			continue
+		}
+		policyModel := AlertPolicySummaryDtoToModel(model.TeamID.ValueString(), policy)
+		policyValues = append(policyValues, policyModel.AsValue())
+	}
+	model.AlertPolicies = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.PolicySummaryModelMap}, policyValues)
+
+	tflog.Trace(ctx, "Successfully read alert policies data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlertPoliciesDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	policyName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_alert_policy" "example" {
+  name    = "` + policyName + `"
+  type    = "alert"
+  team_id = atlassian-operations_team.example.id
+  enabled = true
+  message = "Test alert message"
+}
+
+resource "atlassian-operations_alert_policy" "other" {
+  name    = "other-` + policyName + `"
+  type    = "alert"
+  team_id = atlassian-operations_team.example.id
+  enabled = false
+  message = "Test alert message"
+}
+
+data "atlassian-operations_alert_policies" "test" {
+	depends_on = ["atlassian-operations_alert_policy.example", "atlassian-operations_alert_policy.other"]
+	team_id = atlassian-operations_team.example.id
+}
+
+data "atlassian-operations_alert_policies" "test_name_regex" {
+	depends_on = ["atlassian-operations_alert_policy.example", "atlassian-operations_alert_policy.other"]
+	team_id    = atlassian-operations_team.example.id
+	name_regex = "^` + policyName + `$"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the data source
+					resource.TestCheckResourceAttr("data.atlassian-operations_alert_policies.test", "alert_policies.#", "2"),
+					// Verify the name filter
+					resource.TestCheckResourceAttr("data.atlassian-operations_alert_policies.test_name_regex", "alert_policies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policies.test_name_regex", "alert_policies.0.id", "atlassian-operations_alert_policy.example", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policies.test_name_regex", "alert_policies.0.team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_alert_policies.test_name_regex", "alert_policies.0.name", policyName),
+					resource.TestCheckResourceAttr("data.atlassian-operations_alert_policies.test_name_regex", "alert_policies.0.type", "alert"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_alert_policies.test_name_regex", "alert_policies.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_alert_policies.test_name_regex", "alert_policies.0.order", "atlassian-operations_alert_policy.example", "order"),
+				),
+			},
+		},
+	})
+}
@@ -232,8 +232,9 @@ func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert policy, got error: %s", err))
 		return
 	}
-	order := getAlertPolicyOrder(ctx, r.clientConfiguration, data.TeamID.ValueString(), alertPolicyDto.ID)
-	result, _ := AlertPolicyDtoToModel(ctx, order, alertPolicyDto)
+	// The planned order is kept, since deleting another policy in the same apply can shift this policy, and the next
+	// read picks up the new order
+	result, _ := AlertPolicyDtoToModel(ctx, data.Order.ValueInt64(), alertPolicyDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
@@ -301,9 +302,11 @@ func (r *AlertPolicyResource) ImportState(ctx context.Context, req resource.Impo
 func getAlertPolicyOrder(ctx context.Context, configuration dto.AtlassianOpsProviderModel, teamId string, alertPolicyId string) int64 {
 	// list alert policies find the one we just created, and get its order value
 	listAlertPoliciesResponse := &dto.AlertPolicyListDto{}
-	baseURL := fmt.Sprintf("/v1/teams/%s/policies", teamId)
-	queryParams := map[string]string{
-		"type": "alert",
+	baseURL := "/v1/alerts/policies"
+	queryParams := map[string]string{}
+	if teamId != "" {
+		baseURL = fmt.Sprintf("/v1/teams/%s/policies", teamId)
+		queryParams["type"] = "alert"
 	}
 	var order int64
 	doneLooping := false
@@ -32,7 +32,6 @@ func TestAccApiIntegrationResource_Api(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				ExpectNonEmptyPlan: true,
 				Config: providerConfig + `
 data "atlassian-operations_user" "test1" {
 	email_address = "` + apiPrimary + `"
@@ -89,7 +88,6 @@ resource "atlassian-operations_api_integration" "example" {
 			},
 			// Update and Read testing
 			{
-				ExpectNonEmptyPlan: true,
 				Config: providerConfig + `
 data "atlassian-operations_user" "test1" {
 	email_address = "` + apiPrimary + `"
@@ -161,7 +159,6 @@ func TestAccApiIntegrationResource_SecurityHub(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				ExpectNonEmptyPlan: true,
 				Config: providerConfig + `
 data "atlassian-operations_user" "test1" {
 	email_address = "` + apiPrimary + `"
@@ -230,7 +227,6 @@ resource "atlassian-operations_api_integration" "example" {
 			},
 			// Update and Read testing
 			{
-				ExpectNonEmptyPlan: true,
 				Config: providerConfig + `
 data "atlassian-operations_user" "test1" {
 	email_address = "` + apiPrimary + `"
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ChatIntegrationResource{}
+var _ resource.ResourceWithImportState = &ChatIntegrationResource{}
+
+func NewSlackIntegrationResource() resource.Resource {
+	return &ChatIntegrationResource{
+		typeName:        "_slack_integration",
+		integrationType: "Slack",
+		attributes:      schemaAttributes.SlackIntegrationResourceAttributes,
+	}
+}
+
+func NewMicrosoftTeamsIntegrationResource() resource.Resource {
+	return &ChatIntegrationResource{
+		typeName:        "_microsoft_teams_integration",
+		integrationType: "MicrosoftTeams",
+		attributes:      schemaAttributes.MicrosoftTeamsIntegrationResourceAttributes,
+	}
+}
+
+// ChatIntegrationResource defines the resource implementation shared by the chat integrations. They are stored as
+// integrations of the given type, with the channel configuration in their type specific properties.
+type ChatIntegrationResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+	typeName            string
+	integrationType     string
+	attributes          map[string]schema.Attribute
+}
+
+func (r *ChatIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *ChatIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: r.attributes,
+	}
+}
+
+func (r *ChatIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, fmt.Sprintf("Configuring ChatIntegrationResource for %s", r.integrationType))
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Unexpected Resource Configure Type")
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+
+	tflog.Trace(ctx, fmt.Sprintf("Configured ChatIntegrationResource for %s", r.integrationType))
+}
+
+func (r *ChatIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, fmt.Sprintf("Creating the %s integration", r.integrationType))
+
+	var data dataModels.ChatIntegrationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dtoObj := ChatIntegrationModelToDto(ctx, data, r.integrationType)
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl("v1/integrations").
+		Method(httpClient.POST).
+		SetBody(dtoObj).
+		SetBodyParseObject(&dtoObj).
+		Send()
+
+	handleHttpResponse(httpResp, err, fmt.Sprintf("create %s integration", r.integrationType), &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = ChatIntegrationDtoToModel(dtoObj)
+
+	tflog.Trace(ctx, fmt.Sprintf("Created the %s integration", r.integrationType))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChatIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataModels.ChatIntegrationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Reading the %s integration", r.integrationType))
+
+	dtoObj := dto.ApiIntegration{}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&dtoObj).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	handleHttpResponse(httpResp, err, fmt.Sprintf("read %s integration", r.integrationType), &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if dtoObj.Type != r.integrationType {
+		resp.Diagnostics.AddError(
+			"Unexpected Integration Type",
+			fmt.Sprintf("Integration %s is of type '%s', expected '%s'", data.Id.ValueString(), dtoObj.Type, r.integrationType),
+		)
+		return
+	}
+
+	data = ChatIntegrationDtoToModel(dtoObj)
+
+	tflog.Trace(ctx, fmt.Sprintf("Read the %s integration", r.integrationType))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChatIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data dataModels.ChatIntegrationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Updating the %s integration", r.integrationType))
+
+	dtoObj := ChatIntegrationModelToDto(ctx, data, r.integrationType)
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.PATCH).
+		SetBody(dtoObj).
+		SetBodyParseObject(&dtoObj).
+		Send()
+
+	handleHttpResponse(httpResp, err, fmt.Sprintf("update %s integration", r.integrationType), &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = ChatIntegrationDtoToModel(dtoObj)
+
+	tflog.Trace(ctx, fmt.Sprintf("Updated the %s integration", r.integrationType))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChatIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dataModels.ChatIntegrationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Deleting the %s integration", r.integrationType))
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.DELETE).
+		Send()
+
+	handleHttpResponse(httpResp, err, fmt.Sprintf("delete %s integration", r.integrationType), &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Deleted the %s integration", r.integrationType))
+}
+
+func (r *ChatIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
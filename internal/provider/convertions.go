@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
@@ -28,6 +29,40 @@ func ResponderInfoDtoToModel(dto dto.ResponderInfo) dataModels.ResponderInfoMode
 	return model
 }
 
+func ScheduleOverrideDtoToModel(scheduleId string, dto dto.ScheduleOverride) (dataModels.ScheduleOverrideModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	responder := ResponderInfoDtoToModel(dto.User)
+
+	model := dataModels.ScheduleOverrideModel{
+		Id:         types.StringValue(dto.Alias),
+		ScheduleId: types.StringValue(scheduleId),
+		Alias:      types.StringValue(dto.Alias),
+		Responder:  responder.AsValue(),
+		StartDate:  rfc3339DtoToModel(dto.StartDate, &diags),
+		EndDate:    rfc3339DtoToModel(dto.EndDate, &diags),
+	}
+
+	rotationIds := make([]attr.Value, len(dto.Rotations))
+	for i, rotation := range dto.Rotations {
+		rotationIds[i] = types.StringValue(rotation.Id)
+	}
+	model.RotationIds = types.SetValueMust(types.StringType, rotationIds)
+
+	return model, diags
+}
+
+// rfc3339DtoToModel converts a date returned by the API into an RFC3339 value. Empty dates become null, and dates that
+// are not in RFC3339 format are reported instead of panicking.
+func rfc3339DtoToModel(date string, diags *diag.Diagnostics) timetypes.RFC3339 {
+	if date == "" {
+		return timetypes.NewRFC3339Null()
+	}
+
+	value, valueDiags := timetypes.NewRFC3339Value(date)
+	diags.Append(valueDiags...)
+	return value
+}
+
 func RotationDtoToModel(scheduleId string, dto dto.Rotation) dataModels.RotationModel {
 	model := dataModels.RotationModel{
 		Id:              types.StringValue(dto.Id),
@@ -63,52 +98,7 @@ func RotationDtoToModel(scheduleId string, dto dto.Rotation) dataModels.Rotation
 	model.Participants = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.ResponderInfoModelMap}, participants)
 
 	if dto.TimeRestriction != nil {
-		attributes := map[string]attr.Value{
-			"type":        types.StringValue(string(dto.TimeRestriction.Type)),
-			"restriction": types.ObjectNull(dataModels.TimeOfDayTimeRestrictionSettingsModelMap),
-			"restrictions": types.ListNull(
-				types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
-			),
-		}
-
-		if dto.TimeRestriction.TimeOfDayRestriction != nil {
-			attributes["restriction"] = types.ObjectValueMust(
-				dataModels.TimeOfDayTimeRestrictionSettingsModelMap,
-				map[string]attr.Value{
-					"start_hour": types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.StartHour),
-					"end_hour":   types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.EndHour),
-					"start_min":  types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.StartMin),
-					"end_min":    types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.EndMin),
-				},
-			)
-		}
-
-		if dto.TimeRestriction.WeekAndTimeOfDayRestriction != nil {
-			restrictions := make([]attr.Value, len(*dto.TimeRestriction.WeekAndTimeOfDayRestriction))
-			for i, restriction := range *dto.TimeRestriction.WeekAndTimeOfDayRestriction {
-				restrictions[i], _ = types.ObjectValue(
-					dataModels.WeekdayTimeRestrictionSettingsModelMap,
-					map[string]attr.Value{
-						"start_day":  types.StringValue(string(restriction.StartDay)),
-						"end_day":    types.StringValue(string(restriction.EndDay)),
-						"start_hour": types.Int32Value(restriction.StartHour),
-						"end_hour":   types.Int32Value(restriction.EndHour),
-						"start_min":  types.Int32Value(restriction.StartMin),
-						"end_min":    types.Int32Value(restriction.EndMin),
-					},
-				)
-			}
-
-			attributes["restrictions"] = types.ListValueMust(
-				types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
-				restrictions,
-			)
-		}
-
-		model.TimeRestriction = types.ObjectValueMust(
-			dataModels.TimeRestrictionModelMap,
-			attributes,
-		)
+		model.TimeRestriction = TimeRestrictionDtoToModel(*dto.TimeRestriction)
 	}
 
 	return model
@@ -126,6 +116,27 @@ func ScheduleDtoToModel(dto dto.Schedule) dataModels.ScheduleModel {
 	return model
 }
 
+func ScheduleDataSourceDtoToModel(dto dto.Schedule, ignoreCase types.Bool) dataModels.ScheduleDataSourceModel {
+	scheduleModel := ScheduleDtoToModel(dto)
+
+	rotations := make([]attr.Value, len(dto.Rotations))
+	for i, rotation := range dto.Rotations {
+		rotationModel := RotationDtoToModel(dto.Id, rotation)
+		rotations[i] = rotationModel.AsValue()
+	}
+
+	return dataModels.ScheduleDataSourceModel{
+		Id:          scheduleModel.Id,
+		Name:        scheduleModel.Name,
+		IgnoreCase:  ignoreCase,
+		Description: scheduleModel.Description,
+		Timezone:    scheduleModel.Timezone,
+		Enabled:     scheduleModel.Enabled,
+		TeamId:      scheduleModel.TeamId,
+		Rotations:   types.ListValueMust(types.ObjectType{AttrTypes: dataModels.RotationModelMap}, rotations),
+	}
+}
+
 func EmailIntegrationTypeSpecificPropertiesModelToDto(model dataModels.TypeSpecificPropertiesModel) dto.TypeSpecificPropertiesDto {
 	return dto.TypeSpecificPropertiesDto{
 		EmailUsername:         model.EmailUsername.ValueString(),
@@ -212,7 +223,256 @@ func EmailIntegrationDtoToModel(dto dto.EmailIntegration) dataModels.EmailIntegr
 	return model
 }
 
-func TeamDtoToModel(dto dto.TeamDto, membersDto []dto.TeamMember, deleteDefaultResources types.Bool) dataModels.TeamModel {
+func WebhookIntegrationTypeSpecificPropertiesModelToDto(ctx context.Context, model dataModels.WebhookIntegrationTypeSpecificPropertiesModel) dto.WebhookIntegrationTypeSpecificPropertiesDto {
+	headers := make(map[string]string, len(model.Headers.Elements()))
+	if !(model.Headers.IsNull() || model.Headers.IsUnknown()) {
+		model.Headers.ElementsAs(ctx, &headers, false)
+	}
+
+	return dto.WebhookIntegrationTypeSpecificPropertiesDto{
+		Url:                 model.Url.ValueString(),
+		Headers:             headers,
+		AddAlertDescription: model.AddAlertDescription.ValueBool(),
+		AddAlertDetails:     model.AddAlertDetails.ValueBool(),
+	}
+}
+
+func WebhookIntegrationModelToDto(ctx context.Context, model dataModels.WebhookIntegrationModel) dto.WebhookIntegration {
+	dtoObj := dto.WebhookIntegration{
+		Id:      model.Id.ValueString(),
+		Name:    model.Name.ValueString(),
+		Enabled: model.Enabled.ValueBool(),
+		TeamId:  model.TeamId.ValueString(),
+		Type:    "Webhook",
+	}
+
+	if !(model.TypeSpecificProperties.IsNull() || model.TypeSpecificProperties.IsUnknown()) {
+		var typeSpecificProperties dataModels.WebhookIntegrationTypeSpecificPropertiesModel
+		model.TypeSpecificProperties.As(ctx, &typeSpecificProperties, basetypes.ObjectAsOptions{})
+
+		dtoObj.TypeSpecificProperties = WebhookIntegrationTypeSpecificPropertiesModelToDto(ctx, typeSpecificProperties)
+	}
+
+	return dtoObj
+}
+
+func WebhookIntegrationTypeSpecificPropertiesDtoToModel(dto dto.WebhookIntegrationTypeSpecificPropertiesDto) dataModels.WebhookIntegrationTypeSpecificPropertiesModel {
+	headers := make(map[string]attr.Value, len(dto.Headers))
+	for name, value := range dto.Headers {
+		headers[name] = types.StringValue(value)
+	}
+
+	return dataModels.WebhookIntegrationTypeSpecificPropertiesModel{
+		Url:                 types.StringValue(dto.Url),
+		Headers:             types.MapValueMust(types.StringType, headers),
+		AddAlertDescription: types.BoolValue(dto.AddAlertDescription),
+		AddAlertDetails:     types.BoolValue(dto.AddAlertDetails),
+	}
+}
+
+func WebhookIntegrationDtoToModel(dto dto.WebhookIntegration) dataModels.WebhookIntegrationModel {
+	model := dataModels.WebhookIntegrationModel{
+		Id:       types.StringValue(dto.Id),
+		Name:     types.StringValue(dto.Name),
+		Enabled:  types.BoolValue(dto.Enabled),
+		Advanced: types.BoolValue(dto.Advanced),
+		TeamId:   types.StringValue(dto.TeamId),
+	}
+
+	typeSpecificProperties := WebhookIntegrationTypeSpecificPropertiesDtoToModel(dto.TypeSpecificProperties)
+	model.TypeSpecificProperties = typeSpecificProperties.AsValue()
+
+	directions := make([]attr.Value, len(dto.Directions))
+	for i, direction := range dto.Directions {
+		directions[i] = types.StringValue(direction)
+	}
+	model.Directions = types.ListValueMust(types.StringType, directions)
+
+	domains := make([]attr.Value, len(dto.Domains))
+	for i, domain := range dto.Domains {
+		domains[i] = types.StringValue(domain)
+	}
+	model.Domains = types.ListValueMust(types.StringType, domains)
+
+	maintenanceSources := make([]attr.Value, len(dto.MaintenanceSources))
+	for i, maintenanceSource := range dto.MaintenanceSources {
+		maintenanceSourceModel := ApiIntegrationMaintenanceSourceDtoToModel(maintenanceSource)
+		maintenanceSources[i] = maintenanceSourceModel.AsValue()
+	}
+	model.MaintenanceSources = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.IntegrationMaintenanceSourcesResponseModelMap}, maintenanceSources)
+
+	return model
+}
+
+func ChatIntegrationTypeSpecificPropertiesModelToDto(ctx context.Context, model dataModels.ChatIntegrationTypeSpecificPropertiesModel) dto.ChatIntegrationTypeSpecificPropertiesDto {
+	alertActions := make([]string, 0, len(model.AlertActions.Elements()))
+	model.AlertActions.ElementsAs(ctx, &alertActions, false)
+
+	dtoObj := dto.ChatIntegrationTypeSpecificPropertiesDto{
+		Workspace:    model.Workspace.ValueString(),
+		Channel:      model.Channel.ValueString(),
+		AlertActions: alertActions,
+	}
+
+	if !(model.Filter.IsNull() || model.Filter.IsUnknown()) {
+		var filter dataModels.CriteriaModel
+		model.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{})
+
+		dtoObj.AlertFilter = CriteriaModelToDto(ctx, filter)
+	}
+
+	return dtoObj
+}
+
+// ChatIntegrationModelToDto converts a chat integration into the generic integration payload of the given type,
+// so that it is sent to the integrations endpoint exactly like an API integration.
+func ChatIntegrationModelToDto(ctx context.Context, model dataModels.ChatIntegrationModel, integrationType string) dto.ApiIntegration {
+	typeSpecificProperties := "{}"
+	if !(model.TypeSpecificProperties.IsNull() || model.TypeSpecificProperties.IsUnknown()) {
+		var typeSpecificPropertiesModel dataModels.ChatIntegrationTypeSpecificPropertiesModel
+		model.TypeSpecificProperties.As(ctx, &typeSpecificPropertiesModel, basetypes.ObjectAsOptions{})
+
+		typeSpecificPropertiesJson, _ := json.Marshal(ChatIntegrationTypeSpecificPropertiesModelToDto(ctx, typeSpecificPropertiesModel))
+		typeSpecificProperties = string(typeSpecificPropertiesJson)
+	}
+
+	return ApiIntegrationModelToDto(ctx, dataModels.ApiIntegrationModel{
+		Id:                     model.Id,
+		Name:                   model.Name,
+		Type:                   types.StringValue(integrationType),
+		Enabled:                model.Enabled,
+		TeamId:                 model.TeamId,
+		MaintenanceSources:     types.ListNull(types.ObjectType{AttrTypes: dataModels.IntegrationMaintenanceSourcesResponseModelMap}),
+		Directions:             types.ListNull(types.StringType),
+		Domains:                types.ListNull(types.StringType),
+		TypeSpecificProperties: customTypes.NewLenientJsonValue(typeSpecificProperties),
+	})
+}
+
+func ChatIntegrationTypeSpecificPropertiesDtoToModel(dto dto.ChatIntegrationTypeSpecificPropertiesDto) dataModels.ChatIntegrationTypeSpecificPropertiesModel {
+	alertActions := make([]attr.Value, len(dto.AlertActions))
+	for i, alertAction := range dto.AlertActions {
+		alertActions[i] = types.StringValue(alertAction)
+	}
+
+	model := dataModels.ChatIntegrationTypeSpecificPropertiesModel{
+		Workspace:    types.StringValue(dto.Workspace),
+		Channel:      types.StringValue(dto.Channel),
+		AlertActions: types.SetValueMust(types.StringType, alertActions),
+		Filter:       types.ObjectNull(dataModels.CriteriaModelMap),
+	}
+
+	if dto.AlertFilter != nil {
+		filter := CriteriaDtoToModel(dto.AlertFilter)
+		model.Filter = filter.AsValue()
+	}
+
+	return model
+}
+
+func ChatIntegrationDtoToModel(dtoObj dto.ApiIntegration) dataModels.ChatIntegrationModel {
+	apiIntegrationModel := ApiIntegrationDtoToModel(dtoObj, dataModels.ApiIntegrationModel{})
+
+	var typeSpecificPropertiesDto dto.ChatIntegrationTypeSpecificPropertiesDto
+	apiIntegrationModel.TypeSpecificProperties.Unmarshal(&typeSpecificPropertiesDto)
+	typeSpecificProperties := ChatIntegrationTypeSpecificPropertiesDtoToModel(typeSpecificPropertiesDto)
+
+	return dataModels.ChatIntegrationModel{
+		Id:                     apiIntegrationModel.Id,
+		Name:                   apiIntegrationModel.Name,
+		Enabled:                apiIntegrationModel.Enabled,
+		TeamId:                 apiIntegrationModel.TeamId,
+		Advanced:               apiIntegrationModel.Advanced,
+		Directions:             apiIntegrationModel.Directions,
+		Domains:                apiIntegrationModel.Domains,
+		MaintenanceSources:     apiIntegrationModel.MaintenanceSources,
+		TypeSpecificProperties: typeSpecificProperties.AsValue(),
+	}
+}
+
+// MonitoringIntegrationModelToDto converts a monitoring tool integration into the generic integration payload of the
+// given type. The type specific properties are renamed to their API names using propertyKeys.
+func MonitoringIntegrationModelToDto(ctx context.Context, model dataModels.MonitoringIntegrationModel, integrationType string, propertyKeys map[string]string) dto.ApiIntegration {
+	typeSpecificProperties := make(map[string]interface{})
+	if !(model.TypeSpecificProperties.IsNull() || model.TypeSpecificProperties.IsUnknown()) {
+		for name, value := range model.TypeSpecificProperties.Attributes() {
+			// Unset properties are sent as null, so that they are also cleared on update
+			if value.IsNull() || value.IsUnknown() {
+				typeSpecificProperties[propertyKeys[name]] = nil
+				continue
+			}
+			switch property := value.(type) {
+			case types.String:
+				typeSpecificProperties[propertyKeys[name]] = property.ValueString()
+			case types.Bool:
+				typeSpecificProperties[propertyKeys[name]] = property.ValueBool()
+			case types.Int64:
+				typeSpecificProperties[propertyKeys[name]] = property.ValueInt64()
+			}
+		}
+	}
+	typeSpecificPropertiesJson, _ := json.Marshal(typeSpecificProperties)
+
+	return ApiIntegrationModelToDto(ctx, dataModels.ApiIntegrationModel{
+		Id:                     model.Id,
+		Name:                   model.Name,
+		Type:                   types.StringValue(integrationType),
+		Enabled:                model.Enabled,
+		TeamId:                 model.TeamId,
+		MaintenanceSources:     types.ListNull(types.ObjectType{AttrTypes: dataModels.IntegrationMaintenanceSourcesResponseModelMap}),
+		Directions:             types.ListNull(types.StringType),
+		Domains:                types.ListNull(types.StringType),
+		TypeSpecificProperties: customTypes.NewLenientJsonValue(string(typeSpecificPropertiesJson)),
+	})
+}
+
+// MonitoringIntegrationDtoToModel converts an integration of a monitoring tool back to its typed model. Only the
+// properties in propertyTypes are read from the type specific properties, using their API names in propertyKeys.
+// The webhook URL is left to the caller, as it depends on the provider configuration.
+func MonitoringIntegrationDtoToModel(dtoObj dto.ApiIntegration, oldModel dataModels.MonitoringIntegrationModel, propertyTypes map[string]attr.Type, propertyKeys map[string]string) dataModels.MonitoringIntegrationModel {
+	apiIntegrationModel := ApiIntegrationDtoToModel(dtoObj, dataModels.ApiIntegrationModel{ApiKey: oldModel.ApiKey})
+
+	properties := make(map[string]attr.Value, len(propertyTypes))
+	for name, propertyType := range propertyTypes {
+		value := dtoObj.TypeSpecificProperties[propertyKeys[name]]
+		switch propertyType {
+		case types.StringType:
+			if stringValue, ok := value.(string); ok {
+				properties[name] = types.StringValue(stringValue)
+			} else {
+				properties[name] = types.StringNull()
+			}
+		case types.BoolType:
+			if boolValue, ok := value.(bool); ok {
+				properties[name] = types.BoolValue(boolValue)
+			} else {
+				properties[name] = types.BoolNull()
+			}
+		case types.Int64Type:
+			if numberValue, ok := value.(float64); ok {
+				properties[name] = types.Int64Value(int64(numberValue))
+			} else {
+				properties[name] = types.Int64Null()
+			}
+		}
+	}
+
+	return dataModels.MonitoringIntegrationModel{
+		Id:                     apiIntegrationModel.Id,
+		Name:                   apiIntegrationModel.Name,
+		ApiKey:                 apiIntegrationModel.ApiKey,
+		WebhookUrl:             types.StringNull(),
+		Enabled:                apiIntegrationModel.Enabled,
+		TeamId:                 apiIntegrationModel.TeamId,
+		Advanced:               apiIntegrationModel.Advanced,
+		Directions:             apiIntegrationModel.Directions,
+		Domains:                apiIntegrationModel.Domains,
+		MaintenanceSources:     apiIntegrationModel.MaintenanceSources,
+		TypeSpecificProperties: types.ObjectValueMust(propertyTypes, properties),
+	}
+}
+
+func TeamDtoToModel(dto dto.TeamDto, membersDto []dto.TeamMember, deleteDefaultResources types.Bool, manageMembers types.Bool) dataModels.TeamModel {
 	model := dataModels.TeamModel{
 		Description:            types.StringValue(dto.Description),
 		DisplayName:            types.StringValue(dto.DisplayName),
@@ -222,7 +482,12 @@ func TeamDtoToModel(dto dto.TeamDto, membersDto []dto.TeamMember, deleteDefaultR
 		TeamType:               types.StringValue(string(dto.TeamType)),
 		UserPermissions:        types.ObjectNull(dataModels.PublicApiUserPermissionsModelMap),
 		Member:                 types.SetNull(types.ObjectType{AttrTypes: dataModels.TeamMemberModelMap}),
+		AdminAccountIds:        types.SetNull(types.StringType),
 		DeleteDefaultResources: deleteDefaultResources,
+		ManageMembers:          manageMembers,
+		DefaultScheduleId:      types.StringNull(),
+		DefaultEscalationId:    types.StringNull(),
+		DefaultRoutingRuleId:   types.StringNull(),
 	}
 
 	if dto.SiteId != nil {
@@ -245,6 +510,26 @@ func TeamDtoToModel(dto dto.TeamDto, membersDto []dto.TeamMember, deleteDefaultR
 	return model
 }
 
+func TeamDataSourceDtoToModel(dto dto.TeamDto, membersDto []dto.TeamMember) dataModels.TeamDataSourceModel {
+	model := TeamDtoToModel(dto, membersDto, types.BoolValue(false), types.BoolValue(true))
+
+	return dataModels.TeamDataSourceModel{
+		Description:            model.Description,
+		DisplayName:            model.DisplayName,
+		OrganizationId:         model.OrganizationId,
+		Id:                     model.Id,
+		SiteId:                 model.SiteId,
+		TeamType:               model.TeamType,
+		UserPermissions:        model.UserPermissions,
+		Member:                 model.Member,
+		DeleteDefaultResources: model.DeleteDefaultResources,
+		ManageMembers:          model.ManageMembers,
+		DefaultScheduleId:      model.DefaultScheduleId,
+		DefaultEscalationId:    model.DefaultEscalationId,
+		DefaultRoutingRuleId:   model.DefaultRoutingRuleId,
+	}
+}
+
 func TeamMemberDtoToModel(teamMember dto.TeamMember) dataModels.TeamMemberModel {
 	return dataModels.TeamMemberModel{
 		AccountId: types.StringValue(teamMember.AccountId),
@@ -260,6 +545,24 @@ func PublicApiUserPermissionsDtoToModel(dto dto.PublicApiUserPermissions) dataMo
 	}
 }
 
+func GroupDtoToModel(dto dto.GroupPickerGroupDto) dataModels.GroupModel {
+	return dataModels.GroupModel{
+		GroupId: types.StringValue(dto.GroupId),
+		Name:    types.StringValue(dto.Name),
+	}
+}
+
+func ProjectDtoToModel(dto dto.ProjectDto) dataModels.ProjectModel {
+	return dataModels.ProjectModel{
+		Id:             types.StringValue(dto.Id),
+		Key:            types.StringValue(dto.Key),
+		Name:           types.StringValue(dto.Name),
+		Description:    types.StringValue(dto.Description),
+		ProjectTypeKey: types.StringValue(dto.ProjectTypeKey),
+		Self:           types.StringValue(dto.Self),
+	}
+}
+
 func UserDtoToModel(dto dto.UserDto) dataModels.UserModel {
 	model := dataModels.UserModel{
 		AccountId:    types.StringValue(dto.AccountId),
@@ -416,6 +719,30 @@ func RotationModelToDto(ctx context.Context, model dataModels.RotationModel) dto
 	return dtoObj
 }
 
+func ScheduleOverrideModelToDto(ctx context.Context, model dataModels.ScheduleOverrideModel) dto.ScheduleOverride {
+	dtoObj := dto.ScheduleOverride{
+		Alias:     model.Alias.ValueString(),
+		StartDate: model.StartDate.ValueString(),
+		EndDate:   model.EndDate.ValueString(),
+		Rotations: make([]dto.ScheduleOverrideRotation, 0),
+	}
+
+	if !(model.Responder.IsNull() || model.Responder.IsUnknown()) {
+		var responder dataModels.ResponderInfoModel
+		model.Responder.As(ctx, &responder, basetypes.ObjectAsOptions{})
+		dtoObj.User = ResponderInfoModelToDto(responder)
+	}
+
+	var rotationIds []string
+	model.RotationIds.ElementsAs(ctx, &rotationIds, false)
+
+	for _, rotationId := range rotationIds {
+		dtoObj.Rotations = append(dtoObj.Rotations, dto.ScheduleOverrideRotation{Id: rotationId})
+	}
+
+	return dtoObj
+}
+
 func ResponderInfoModelToDto(model dataModels.ResponderInfoModel) dto.ResponderInfo {
 	return dto.ResponderInfo{
 		Id:   model.Id.ValueStringPointer(),
@@ -423,6 +750,55 @@ func ResponderInfoModelToDto(model dataModels.ResponderInfoModel) dto.ResponderI
 	}
 }
 
+func TimeRestrictionDtoToModel(dto dto.TimeRestriction) types.Object {
+	attributes := map[string]attr.Value{
+		"type":        types.StringValue(string(dto.Type)),
+		"restriction": types.ObjectNull(dataModels.TimeOfDayTimeRestrictionSettingsModelMap),
+		"restrictions": types.ListNull(
+			types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
+		),
+	}
+
+	if dto.TimeOfDayRestriction != nil {
+		attributes["restriction"] = types.ObjectValueMust(
+			dataModels.TimeOfDayTimeRestrictionSettingsModelMap,
+			map[string]attr.Value{
+				"start_hour": types.Int32Value(dto.TimeOfDayRestriction.StartHour),
+				"end_hour":   types.Int32Value(dto.TimeOfDayRestriction.EndHour),
+				"start_min":  types.Int32Value(dto.TimeOfDayRestriction.StartMin),
+				"end_min":    types.Int32Value(dto.TimeOfDayRestriction.EndMin),
+			},
+		)
+	}
+
+	if dto.WeekAndTimeOfDayRestriction != nil {
+		restrictions := make([]attr.Value, len(*dto.WeekAndTimeOfDayRestriction))
+		for i, restriction := range *dto.WeekAndTimeOfDayRestriction {
+			restrictions[i], _ = types.ObjectValue(
+				dataModels.WeekdayTimeRestrictionSettingsModelMap,
+				map[string]attr.Value{
+					"start_day":  types.StringValue(string(restriction.StartDay)),
+					"end_day":    types.StringValue(string(restriction.EndDay)),
+					"start_hour": types.Int32Value(restriction.StartHour),
+					"end_hour":   types.Int32Value(restriction.EndHour),
+					"start_min":  types.Int32Value(restriction.StartMin),
+					"end_min":    types.Int32Value(restriction.EndMin),
+				},
+			)
+		}
+
+		attributes["restrictions"] = types.ListValueMust(
+			types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
+			restrictions,
+		)
+	}
+
+	return types.ObjectValueMust(
+		dataModels.TimeRestrictionModelMap,
+		attributes,
+	)
+}
+
 func TimeRestrictionModelToDto(ctx context.Context, model dataModels.TimeRestrictionModel) *dto.TimeRestriction {
 	dtoObj := dto.TimeRestriction{
 		Type: dto.TimeRestrictionType(model.Type.ValueString()),
@@ -728,7 +1104,7 @@ func ApiIntegrationDtoToModel(dtoObj dto.ApiIntegration, oldModel dataModels.Api
 		MaintenanceSources:     types.ListNull(types.ObjectType{AttrTypes: dataModels.IntegrationMaintenanceSourcesResponseModelMap}),
 		Directions:             types.ListNull(types.StringType),
 		Domains:                types.ListNull(types.StringType),
-		TypeSpecificProperties: jsontypes.NewExactValue(string(typeSpecificProperties)),
+		TypeSpecificProperties: customTypes.NewLenientJsonValue(string(typeSpecificProperties)),
 		DeleteDefaultActions:   oldModel.DeleteDefaultActions,
 	}
 
@@ -768,6 +1144,22 @@ func ApiIntegrationDtoToModel(dtoObj dto.ApiIntegration, oldModel dataModels.Api
 	return model
 }
 
+func IntegrationDtoToModel(dtoObj dto.ApiIntegration) dataModels.IntegrationModel {
+	apiIntegrationModel := ApiIntegrationDtoToModel(dtoObj, dataModels.ApiIntegrationModel{})
+	return dataModels.IntegrationModel{
+		Id:                     apiIntegrationModel.Id,
+		Name:                   apiIntegrationModel.Name,
+		Type:                   apiIntegrationModel.Type,
+		Enabled:                apiIntegrationModel.Enabled,
+		TeamId:                 apiIntegrationModel.TeamId,
+		Advanced:               apiIntegrationModel.Advanced,
+		MaintenanceSources:     apiIntegrationModel.MaintenanceSources,
+		Directions:             apiIntegrationModel.Directions,
+		Domains:                apiIntegrationModel.Domains,
+		TypeSpecificProperties: jsontypes.NewExactValue(apiIntegrationModel.TypeSpecificProperties.ValueString()),
+	}
+}
+
 func CriteriaConditionModelToDto(model dataModels.CriteriaConditionModel) dto.CriteriaConditionDto {
 	return dto.CriteriaConditionDto{
 		Field:         model.Field.ValueString(),
@@ -810,7 +1202,6 @@ func RoutingRuleModelToDto(ctx context.Context, model dataModels.RoutingRuleMode
 	dtoObj := dto.RoutingRuleDto{
 		ID:              model.ID.ValueString(),
 		Name:            model.Name.ValueString(),
-		Order:           model.Order.ValueInt64(),
 		IsDefault:       model.IsDefault.ValueBool(),
 		Timezone:        model.Timezone.ValueString(),
 		Criteria:        nil,
@@ -839,6 +1230,34 @@ func RoutingRuleModelToDto(ctx context.Context, model dataModels.RoutingRuleMode
 	return dtoObj
 }
 
+func TeamDefaultRoutingRuleModelToDto(ctx context.Context, model dataModels.TeamDefaultRoutingRuleModel) dto.RoutingRuleDto {
+	dtoObj := dto.RoutingRuleDto{
+		ID:        model.ID.ValueString(),
+		Name:      model.Name.ValueString(),
+		IsDefault: true,
+		Notify:    nil,
+	}
+
+	if !(model.Notify.IsNull() || model.Notify.IsUnknown()) {
+		var notify dataModels.RoutingRuleNotifyModel
+		model.Notify.As(ctx, &notify, basetypes.ObjectAsOptions{})
+		dtoObj.Notify = RoutingRuleNotifyModelToDto(notify)
+	}
+
+	return dtoObj
+}
+
+func TeamDefaultRoutingRuleDtoToModel(teamId string, dto dto.RoutingRuleDto) dataModels.TeamDefaultRoutingRuleModel {
+	routingRule := RoutingRuleDtoToModel(teamId, dto)
+
+	return dataModels.TeamDefaultRoutingRuleModel{
+		ID:     routingRule.ID,
+		TeamID: routingRule.TeamID,
+		Name:   routingRule.Name,
+		Notify: routingRule.Notify,
+	}
+}
+
 func RoutingRuleDtoToModel(teamId string, dto dto.RoutingRuleDto) dataModels.RoutingRuleModel {
 	model := dataModels.RoutingRuleModel{
 		ID:              types.StringValue(dto.ID),
@@ -899,52 +1318,7 @@ func RoutingRuleDtoToModel(teamId string, dto dto.RoutingRuleDto) dataModels.Rou
 	}
 
 	if dto.TimeRestriction != nil {
-		attributes := map[string]attr.Value{
-			"type":        types.StringValue(string(dto.TimeRestriction.Type)),
-			"restriction": types.ObjectNull(dataModels.TimeOfDayTimeRestrictionSettingsModelMap),
-			"restrictions": types.ListNull(
-				types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
-			),
-		}
-
-		if dto.TimeRestriction.TimeOfDayRestriction != nil {
-			attributes["restriction"] = types.ObjectValueMust(
-				dataModels.TimeOfDayTimeRestrictionSettingsModelMap,
-				map[string]attr.Value{
-					"start_hour": types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.StartHour),
-					"end_hour":   types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.EndHour),
-					"start_min":  types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.StartMin),
-					"end_min":    types.Int32Value(dto.TimeRestriction.TimeOfDayRestriction.EndMin),
-				},
-			)
-		}
-
-		if dto.TimeRestriction.WeekAndTimeOfDayRestriction != nil {
-			restrictions := make([]attr.Value, len(*dto.TimeRestriction.WeekAndTimeOfDayRestriction))
-			for i, restriction := range *dto.TimeRestriction.WeekAndTimeOfDayRestriction {
-				restrictions[i], _ = types.ObjectValue(
-					dataModels.WeekdayTimeRestrictionSettingsModelMap,
-					map[string]attr.Value{
-						"start_day":  types.StringValue(string(restriction.StartDay)),
-						"end_day":    types.StringValue(string(restriction.EndDay)),
-						"start_hour": types.Int32Value(restriction.StartHour),
-						"end_hour":   types.Int32Value(restriction.EndHour),
-						"start_min":  types.Int32Value(restriction.StartMin),
-						"end_min":    types.Int32Value(restriction.EndMin),
-					},
-				)
-			}
-
-			attributes["restrictions"] = types.ListValueMust(
-				types.ObjectType{AttrTypes: dataModels.WeekdayTimeRestrictionSettingsModelMap},
-				restrictions,
-			)
-		}
-
-		model.TimeRestriction = types.ObjectValueMust(
-			dataModels.TimeRestrictionModelMap,
-			attributes,
-		)
+		model.TimeRestriction = TimeRestrictionDtoToModel(*dto.TimeRestriction)
 	}
 
 	return model
@@ -1428,6 +1802,22 @@ func AlertPolicyModelToDto(ctx context.Context, model *dataModels.AlertPolicyMod
 	return &alertPolicyDto, diags
 }
 
+func AlertPolicySummaryDtoToModel(teamId string, dto dto.BaseAlertPolicyDto) dataModels.PolicySummaryModel {
+	teamIdValue := types.StringNull()
+	if teamId != "" {
+		teamIdValue = types.StringValue(teamId)
+	}
+
+	return dataModels.PolicySummaryModel{
+		ID:      types.StringValue(dto.ID),
+		TeamID:  teamIdValue,
+		Name:    types.StringValue(dto.Name),
+		Type:    types.StringValue(dto.Type),
+		Enabled: types.BoolValue(dto.Enabled),
+		Order:   types.Int64Value(int64(dto.Order)),
+	}
+}
+
 func AlertPolicyDtoToModel(_ context.Context, order int64, dto *dto.AlertPolicyDto) (*dataModels.AlertPolicyModel, error) {
 
 	if dto == nil {
@@ -1892,7 +2282,6 @@ func NotificationPolicyModelToDto(ctx context.Context, model *dataModels.Notific
 		Description:         model.Description.ValueString(),
 		TeamID:              model.TeamID.ValueString(),
 		Enabled:             model.Enabled.ValueBool(),
-		Order:               model.Order.ValueFloat64(),
 		Filter:              filter,
 		TimeRestriction:     timeRestriction,
 		AutoRestartAction:   autoRestartAction,
@@ -1903,6 +2292,17 @@ func NotificationPolicyModelToDto(ctx context.Context, model *dataModels.Notific
 	}, diags
 }
 
+func NotificationPolicySummaryDtoToModel(teamId string, dto dto.BaseNotificationPolicyDto) dataModels.NotificationPolicySummaryModel {
+	return dataModels.NotificationPolicySummaryModel{
+		ID:      types.StringValue(dto.ID),
+		TeamID:  types.StringValue(teamId),
+		Name:    types.StringValue(dto.Name),
+		Type:    types.StringValue(dto.Type),
+		Enabled: types.BoolValue(dto.Enabled),
+		Order:   types.Float64Value(dto.Order),
+	}
+}
+
 func NotificationPolicyDtoToModel(ctx context.Context, order float64, dto *dto.NotificationPolicyDto) (*dataModels.NotificationPolicyModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -2232,6 +2632,39 @@ func HeartbeatDtoToModel(ctx context.Context, dto *dto.HeartbeatDto, teamID stri
 	}, diags
 }
 
+func HeartbeatDataSourceDtoToModel(ctx context.Context, dto *dto.HeartbeatDto, teamID string) (*dataModels.HeartbeatDataSourceModel, diag.Diagnostics) {
+	heartbeatModel, diags := HeartbeatDtoToModel(ctx, dto, teamID)
+	if heartbeatModel == nil || diags.HasError() {
+		return nil, diags
+	}
+
+	// A heartbeat that never received a ping has no last ping time
+	lastPingTime := timetypes.NewRFC3339Null()
+	if dto.LastPingTime != "" {
+		var timeDiags diag.Diagnostics
+		lastPingTime, timeDiags = timetypes.NewRFC3339Value(dto.LastPingTime)
+		diags.Append(timeDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return &dataModels.HeartbeatDataSourceModel{
+		Name:          heartbeatModel.Name,
+		Description:   heartbeatModel.Description,
+		Interval:      heartbeatModel.Interval,
+		IntervalUnit:  heartbeatModel.IntervalUnit,
+		Enabled:       heartbeatModel.Enabled,
+		Status:        heartbeatModel.Status,
+		LastPingTime:  lastPingTime,
+		Expired:       types.BoolValue(dto.Expired),
+		TeamID:        heartbeatModel.TeamID,
+		AlertMessage:  heartbeatModel.AlertMessage,
+		AlertTags:     heartbeatModel.AlertTags,
+		AlertPriority: heartbeatModel.AlertPriority,
+	}, diags
+}
+
 func IntegrationActionModelToDto(ctx context.Context, model *dataModels.IntegrationActionModel) (*dto.IntegrationActionDto, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -2367,7 +2800,7 @@ func IntegrationActionDtoToModel(ctx context.Context, dto *dto.IntegrationAction
 			dataModels.ActionMappingModelMap,
 			map[string]attr.Value{
 				"type":      types.StringValue(dto.ActionMapping.Type),
-				"parameter": jsontypes.NewExactValue(string(parameterMap)),
+				"parameter": customTypes.NewLenientJsonValue(string(parameterMap)),
 			},
 		)
 	} else {
@@ -2409,8 +2842,8 @@ func IntegrationActionDtoToModel(ctx context.Context, dto *dto.IntegrationAction
 		Direction:              types.StringValue(dto.Direction),
 		GroupType:              groupType,
 		Filter:                 filter,
-		TypeSpecificProperties: jsontypes.NewExactValue(string(typeSpecificPropsMap)),
-		FieldMappings:          jsontypes.NewExactValue(string(fieldMappingsMap)),
+		TypeSpecificProperties: customTypes.NewLenientJsonValue(string(typeSpecificPropsMap)),
+		FieldMappings:          customTypes.NewLenientJsonValue(string(fieldMappingsMap)),
 		ActionMapping:          actionMapping,
 		Enabled:                enabled,
 	}, diags
@@ -2522,6 +2955,8 @@ func MaintenanceDtoToModel(ctx context.Context, dtoObj *dto.MaintenanceDto) (*da
 		EndDate:     types.StringValue(dtoObj.EndDate),
 		TeamID:      teamId,
 		Rules:       rulesList,
+		Recurrence:  types.ObjectNull(dataModels.MaintenanceRecurrenceModelMap),
+		Occurrences: types.ListNull(types.ObjectType{AttrTypes: dataModels.MaintenanceOccurrenceModelMap}),
 	}, diags
 }
 
@@ -2758,3 +3193,142 @@ func ServiceDtoToModel(ctx context.Context, dto *dto.ServiceDto) (*dataModels.Se
 		Projects:        projects,
 	}, diags
 }
+
+func ForwardingRuleModelToDto(ctx context.Context, model dataModels.ForwardingRuleModel) dto.ForwardingRuleDto {
+	fromUserId := model.FromUserID.ValueString()
+	dtoObj := dto.ForwardingRuleDto{
+		ID:        model.ID.ValueString(),
+		Alias:     model.Alias.ValueString(),
+		FromUser:  dto.ResponderInfo{Id: &fromUserId, Type: dto.User},
+		StartDate: model.StartDate.ValueString(),
+		EndDate:   model.EndDate.ValueString(),
+	}
+
+	if !(model.To.IsNull() || model.To.IsUnknown()) {
+		var to dataModels.ResponderInfoModel
+		model.To.As(ctx, &to, basetypes.ObjectAsOptions{})
+		dtoObj.To = ResponderInfoModelToDto(to)
+	}
+
+	return dtoObj
+}
+
+func ForwardingRuleDtoToModel(dto dto.ForwardingRuleDto) dataModels.ForwardingRuleModel {
+	to := ResponderInfoDtoToModel(dto.To)
+
+	model := dataModels.ForwardingRuleModel{
+		ID:         types.StringValue(dto.ID),
+		Alias:      types.StringValue(dto.Alias),
+		FromUserID: types.StringNull(),
+		To:         to.AsValue(),
+		StartDate:  types.StringValue(dto.StartDate),
+		EndDate:    types.StringNull(),
+	}
+	if dto.FromUser.Id != nil {
+		model.FromUserID = types.StringValue(*dto.FromUser.Id)
+	}
+	if dto.EndDate != "" {
+		model.EndDate = types.StringValue(dto.EndDate)
+	}
+
+	return model
+}
+
+func IncomingCallRoutingModelToDto(ctx context.Context, model dataModels.IncomingCallRoutingModel) dto.IncomingCallRoutingDto {
+	dtoObj := dto.IncomingCallRoutingDto{
+		ID:           model.ID.ValueString(),
+		Name:         model.Name.ValueString(),
+		PhoneNumber:  model.PhoneNumber.ValueString(),
+		Greeting:     model.Greeting.ValueString(),
+		RoutingRules: make([]dto.IncomingCallRoutingRuleDto, 0),
+	}
+
+	var rules []dataModels.IncomingCallRoutingRuleModel
+	model.RoutingRules.ElementsAs(ctx, &rules, false)
+
+	for _, rule := range rules {
+		ruleDto := dto.IncomingCallRoutingRuleDto{
+			Name: rule.Name.ValueString(),
+		}
+
+		if !(rule.TimeRestriction.IsNull() || rule.TimeRestriction.IsUnknown()) {
+			var timeRestriction dataModels.TimeRestrictionModel
+			rule.TimeRestriction.As(ctx, &timeRestriction, basetypes.ObjectAsOptions{})
+			ruleDto.TimeRestriction = TimeRestrictionModelToDto(ctx, timeRestriction)
+		}
+
+		var recipient dataModels.IncomingCallRecipientModel
+		rule.Recipient.As(ctx, &recipient, basetypes.ObjectAsOptions{})
+		ruleDto.Recipient = IncomingCallRecipientModelToDto(recipient)
+
+		dtoObj.RoutingRules = append(dtoObj.RoutingRules, ruleDto)
+	}
+
+	if !(model.Fallback.IsNull() || model.Fallback.IsUnknown()) {
+		var fallback dataModels.IncomingCallRecipientModel
+		model.Fallback.As(ctx, &fallback, basetypes.ObjectAsOptions{})
+		fallbackDto := IncomingCallRecipientModelToDto(fallback)
+		dtoObj.Fallback = &fallbackDto
+	}
+
+	return dtoObj
+}
+
+func IncomingCallRecipientModelToDto(model dataModels.IncomingCallRecipientModel) dto.IncomingCallRecipientDto {
+	return dto.IncomingCallRecipientDto{
+		Type: model.Type.ValueString(),
+		ID:   model.ID.ValueString(),
+	}
+}
+
+func IncomingCallRoutingDtoToModel(teamId string, dto dto.IncomingCallRoutingDto) dataModels.IncomingCallRoutingModel {
+	model := dataModels.IncomingCallRoutingModel{
+		ID:          types.StringValue(dto.ID),
+		TeamID:      types.StringValue(teamId),
+		Name:        types.StringValue(dto.Name),
+		PhoneNumber: types.StringValue(dto.PhoneNumber),
+		Greeting:    types.StringNull(),
+		Fallback:    types.ObjectNull(dataModels.IncomingCallRecipientModelMap),
+	}
+
+	if dto.Greeting != "" {
+		model.Greeting = types.StringValue(dto.Greeting)
+	}
+
+	rules := make([]attr.Value, len(dto.RoutingRules))
+	for i, rule := range dto.RoutingRules {
+		ruleModel := dataModels.IncomingCallRoutingRuleModel{
+			Name:            types.StringNull(),
+			TimeRestriction: types.ObjectNull(dataModels.TimeRestrictionModelMap),
+		}
+		if rule.Name != "" {
+			ruleModel.Name = types.StringValue(rule.Name)
+		}
+		if rule.TimeRestriction != nil {
+			ruleModel.TimeRestriction = TimeRestrictionDtoToModel(*rule.TimeRestriction)
+		}
+		recipient := IncomingCallRecipientDtoToModel(rule.Recipient)
+		ruleModel.Recipient = recipient.AsValue()
+
+		rules[i] = ruleModel.AsValue()
+	}
+	model.RoutingRules = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.IncomingCallRoutingRuleModelMap}, rules)
+
+	if dto.Fallback != nil {
+		fallback := IncomingCallRecipientDtoToModel(*dto.Fallback)
+		model.Fallback = fallback.AsValue()
+	}
+
+	return model
+}
+
+func IncomingCallRecipientDtoToModel(dto dto.IncomingCallRecipientDto) dataModels.IncomingCallRecipientModel {
+	model := dataModels.IncomingCallRecipientModel{
+		Type: types.StringValue(dto.Type),
+		ID:   types.StringNull(),
+	}
+	if dto.ID != "" {
+		model.ID = types.StringValue(dto.ID)
+	}
+	return model
+}
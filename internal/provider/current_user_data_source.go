@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &CurrentUserDataSource{}
+	_ datasource.DataSourceWithConfigure = &CurrentUserDataSource{}
+)
+
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &CurrentUserDataSource{}
+}
+
+// CurrentUserDataSource defines the data source implementation.
+type CurrentUserDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *CurrentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+func (d *CurrentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Current user data source",
+		Attributes:          schemaAttributes.CurrentUserDataSourceAttributes,
+	}
+}
+
+func (d *CurrentUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring current_user_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure current_user_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured current_user_data_source")
+}
+
+func (d *CurrentUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.UserModel
+	productType := d.clientConfiguration.GetProductType()
+
+	tflog.Trace(ctx, "Reading current user data source")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read current user configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	switch productType {
+	case "jira-service-desk":
+		tflog.Trace(ctx, "Sending HTTP request to Jira Myself API")
+
+		var userDto dto.UserDto
+		clientResp, err := httpClientHelpers.
+			GenerateJiraClientRequest(d.clientConfiguration).
+			Method(httpClient.GET).
+			JoinBaseUrl("/myself").
+			SetQueryParams(map[string]string{
+				"expand": "groups,applicationRoles",
+			}).
+			SetBodyParseObject(&userDto).
+			Send()
+
+		handleHttpResponse(clientResp, err, "read current user", &resp.Diagnostics, ctx)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Trace(ctx, "HTTP request to Jira Myself API Succeeded. Parsing the fetched data to Terraform model")
+		model = UserDtoToModel(userDto)
+
+	default:
+		// The Org Admin API has no endpoint for the calling user, so the user is looked up by the provider email address
+		if model.OrganizationId.IsNull() || model.OrganizationId.IsUnknown() {
+			tflog.Error(
+				ctx,
+				fmt.Sprintf("Organization ID is required for %s. Please provide a valid organization ID.", productType),
+			)
+			resp.Diagnostics.AddAttributeError(
+				path.Root("organization_id"),
+				"Missing Required Attribute",
+				fmt.Sprintf("Organization ID is required for %s. Please provide a valid organization ID.", productType),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, "Sending HTTP request to Org Admin User Search API")
+
+		emailAddress := d.clientConfiguration.GetEmailAddress()
+		var searchResponseDto dto.OrgUserSearchResponseDto
+		clientResp, err := httpClientHelpers.
+			GenerateUserClientRequest(d.clientConfiguration).
+			Method(httpClient.GET).
+			JoinBaseUrl(fmt.Sprintf("%s/directories/-/users", model.OrganizationId.ValueString())).
+			SetQueryParams(map[string]string{
+				"searchTerm": emailAddress,
+			}).
+			SetBodyParseObject(&searchResponseDto).
+			Send()
+
+		handleHttpResponse(clientResp, err, "read current user", &resp.Diagnostics, ctx)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The search term also matches names, so the email address is matched exactly here
+		var userDto *dto.OrgUserDto
+		for i := range searchResponseDto.Data {
+			if strings.EqualFold(searchResponseDto.Data[i].Email, emailAddress) {
+				userDto = &searchResponseDto.Data[i]
+				break
+			}
+		}
+
+		if userDto == nil {
+			tflog.Error(ctx, "No users found")
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("No user with the email address %q found in organization %q", emailAddress, model.OrganizationId.ValueString()))
+			return
+		}
+
+		tflog.Trace(ctx, "HTTP request to Org Admin User Search API Succeeded. Parsing the fetched data to Terraform model")
+		model = OrgUserDtoToModel(*userDto, model)
+	}
+
+	tflog.Trace(ctx, "Successfully read current user data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
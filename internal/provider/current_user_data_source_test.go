@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCurrentUserDataSource(t *testing.T) {
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+data "atlassian-operations_current_user" "test" {
+	organization_id = "` + organizationId + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the data source
+					// Verify all attributes are set
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_current_user.test", "account_id"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_current_user.test", "display_name"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_current_user.test", "email_address"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_current_user.test", "active", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,61 @@
+package customTypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*LenientJsonType)(nil)
+
+// LenientJsonType is a JSON string type for objects the server completes and reorders, such as the type specific
+// properties of integrations. See LenientJson for the semantic equality rules.
+type LenientJsonType struct {
+	basetypes.StringType
+}
+
+func (t LenientJsonType) String() string {
+	return "customTypes.LenientJsonType"
+}
+
+func (t LenientJsonType) ValueType(_ context.Context) attr.Value {
+	return LenientJson{}
+}
+
+func (t LenientJsonType) Equal(o attr.Type) bool {
+	other, ok := o.(LenientJsonType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t LenientJsonType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return LenientJson{
+		StringValue: in,
+	}, nil
+}
+
+func (t LenientJsonType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
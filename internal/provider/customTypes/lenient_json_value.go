@@ -0,0 +1,200 @@
+package customTypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*LenientJson)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*LenientJson)(nil)
+	_ xattr.ValidateableAttribute                = (*LenientJson)(nil)
+)
+
+// LenientJson is a JSON string that is semantically equal to a new value when the new value contains everything it
+// contains. Keys the server adds with default values are ignored, a null key matches a missing one, and neither the
+// order of object keys nor the order of array elements matters.
+type LenientJson struct {
+	basetypes.StringValue
+}
+
+func (v LenientJson) Type(_ context.Context) attr.Type {
+	return LenientJsonType{}
+}
+
+func (v LenientJson) Equal(o attr.Value) bool {
+	other, ok := o.(LenientJson)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v LenientJson) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(LenientJson)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorJson, err := decodeJson(v.ValueString())
+	if err != nil {
+		// The prior value was never valid JSON, so it can only be replaced
+		return false, diags
+	}
+
+	newJson, err := decodeJson(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return jsonContains(newJson, priorJson), diags
+}
+
+func (v LenientJson) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+	}
+}
+
+// Unmarshal decodes the JSON string into the target, in the same way as the jsontypes values.
+func (v LenientJson) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError("Lenient JSON Unmarshal Error", "json string value is null")
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError("Lenient JSON Unmarshal Error", "json string value is unknown")
+		return diags
+	}
+
+	if err := json.Unmarshal([]byte(v.ValueString()), target); err != nil {
+		diags.AddError("Lenient JSON Unmarshal Error", err.Error())
+	}
+
+	return diags
+}
+
+func NewLenientJsonNull() LenientJson {
+	return LenientJson{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+func NewLenientJsonUnknown() LenientJson {
+	return LenientJson{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+func NewLenientJsonValue(value string) LenientJson {
+	return LenientJson{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+func decodeJson(value string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	// Keep numbers as written, so that they are compared by value rather than as float64
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+// jsonContains reports whether the actual JSON value contains everything of the expected one.
+func jsonContains(actual interface{}, expected interface{}) bool {
+	// A null value matches a missing one as well as any value the server defaults it to
+	if expected == nil {
+		return true
+	}
+
+	switch expectedValue := expected.(type) {
+	case map[string]interface{}:
+		actualValue, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, expectedElement := range expectedValue {
+			if !jsonContains(actualValue[key], expectedElement) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		actualValue, ok := actual.([]interface{})
+		if !ok || len(actualValue) != len(expectedValue) {
+			return false
+		}
+		return jsonArrayContains(actualValue, expectedValue)
+	case json.Number:
+		actualValue, ok := actual.(json.Number)
+		if !ok {
+			return false
+		}
+		expectedNumber, expectedOk := new(big.Float).SetString(expectedValue.String())
+		actualNumber, actualOk := new(big.Float).SetString(actualValue.String())
+		if !expectedOk || !actualOk {
+			return expectedValue == actualValue
+		}
+		return expectedNumber.Cmp(actualNumber) == 0
+	default:
+		return expected == actual
+	}
+}
+
+// jsonArrayContains matches every expected element to a distinct actual element, regardless of their order.
+func jsonArrayContains(actual []interface{}, expected []interface{}) bool {
+	if len(expected) == 0 {
+		return true
+	}
+
+	for i, actualElement := range actual {
+		if !jsonContains(actualElement, expected[0]) {
+			continue
+		}
+
+		remaining := make([]interface{}, 0, len(actual)-1)
+		remaining = append(remaining, actual[:i]...)
+		remaining = append(remaining, actual[i+1:]...)
+		if jsonArrayContains(remaining, expected[1:]) {
+			return true
+		}
+	}
+
+	return false
+}
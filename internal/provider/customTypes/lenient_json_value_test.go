@@ -0,0 +1,188 @@
+package customTypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestLenientJsonStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		"identical": {
+			prior:    `{"a":"b"}`,
+			new:      `{"a":"b"}`,
+			expected: true,
+		},
+		"reordered keys": {
+			prior:    `{"a":1,"b":2}`,
+			new:      `{"b":2,"a":1}`,
+			expected: true,
+		},
+		"added default key": {
+			prior:    `{"a":1}`,
+			new:      `{"a":1,"b":false}`,
+			expected: true,
+		},
+		"removed key": {
+			prior:    `{"a":1,"b":2}`,
+			new:      `{"a":1}`,
+			expected: false,
+		},
+		"changed value": {
+			prior:    `{"a":"b"}`,
+			new:      `{"a":"c"}`,
+			expected: false,
+		},
+		"null matches missing key": {
+			prior:    `{"a":1,"b":null}`,
+			new:      `{"a":1}`,
+			expected: true,
+		},
+		"null matches defaulted value": {
+			prior:    `{"a":null}`,
+			new:      `{"a":{"b":[1,2]}}`,
+			expected: true,
+		},
+		"nested object with added key": {
+			prior:    `{"a":{"b":{"c":"d"}}}`,
+			new:      `{"a":{"b":{"c":"d","e":"f"},"g":1}}`,
+			expected: true,
+		},
+		"nested object with changed value": {
+			prior:    `{"a":{"b":{"c":"d"}}}`,
+			new:      `{"a":{"b":{"c":"e"}}}`,
+			expected: false,
+		},
+		"nested object replaced by scalar": {
+			prior:    `{"a":{"b":1}}`,
+			new:      `{"a":"b"}`,
+			expected: false,
+		},
+		"reordered array": {
+			prior:    `[1,2,3]`,
+			new:      `[3,1,2]`,
+			expected: true,
+		},
+		"array with different length": {
+			prior:    `[1,2]`,
+			new:      `[1,2,3]`,
+			expected: false,
+		},
+		"duplicate array elements": {
+			prior:    `[1,1,2]`,
+			new:      `[2,1,1]`,
+			expected: true,
+		},
+		"duplicate array elements with different counts": {
+			prior:    `[1,1,2]`,
+			new:      `[1,2,2]`,
+			expected: false,
+		},
+		"array objects needing backtracking": {
+			prior:    `[{"a":1},{"a":1,"b":2}]`,
+			new:      `[{"a":1,"b":2},{"a":1,"c":3}]`,
+			expected: true,
+		},
+		"array objects without a distinct match": {
+			prior:    `[{"a":1,"b":2},{"a":1,"b":2}]`,
+			new:      `[{"a":1,"b":2},{"a":1,"c":3}]`,
+			expected: false,
+		},
+		"integer and decimal number": {
+			prior:    `{"a":1}`,
+			new:      `{"a":1.0}`,
+			expected: true,
+		},
+		"exponent number": {
+			prior:    `{"a":1500}`,
+			new:      `{"a":1.5e3}`,
+			expected: true,
+		},
+		"large numbers beyond float64 precision": {
+			prior:    `{"a":9007199254740993}`,
+			new:      `{"a":9007199254740992}`,
+			expected: false,
+		},
+		"number and string": {
+			prior:    `{"a":1}`,
+			new:      `{"a":"1"}`,
+			expected: false,
+		},
+		"boolean": {
+			prior:    `{"a":true}`,
+			new:      `{"a":false}`,
+			expected: false,
+		},
+		"invalid prior value": {
+			prior:    `{"a":`,
+			new:      `{"a":1}`,
+			expected: false,
+		},
+		"invalid new value": {
+			prior:    `{"a":1}`,
+			new:      `{"a":`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			equal, diags := NewLenientJsonValue(testCase.prior).StringSemanticEquals(context.Background(), NewLenientJsonValue(testCase.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != testCase.expected {
+				t.Errorf("expected %t for %s and %s, got %t", testCase.expected, testCase.prior, testCase.new, equal)
+			}
+		})
+	}
+}
+
+func TestLenientJsonValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       LenientJson
+		expectError bool
+	}{
+		"valid object": {
+			value: NewLenientJsonValue(`{"a":[1,{"b":null}]}`),
+		},
+		"null": {
+			value: NewLenientJsonNull(),
+		},
+		"unknown": {
+			value: NewLenientJsonUnknown(),
+		},
+		"truncated object": {
+			value:       NewLenientJsonValue(`{"a":`),
+			expectError: true,
+		},
+		"not json": {
+			value:       NewLenientJsonValue(`a=b`),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+			testCase.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("test")}, &resp)
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
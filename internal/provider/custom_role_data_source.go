@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &CustomRoleDataSource{}
+	_ datasource.DataSourceWithConfigure = &CustomRoleDataSource{}
+)
+
+func NewCustomRoleDataSource() datasource.DataSource {
+	return &CustomRoleDataSource{}
+}
+
+// CustomRoleDataSource defines the data source implementation.
+type CustomRoleDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *CustomRoleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_role"
+}
+
+func (d *CustomRoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom role data source",
+		Attributes:          schemaAttributes.CustomRoleDataSourceAttributes,
+	}
+}
+
+func (d *CustomRoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring custom_role_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure custom_role_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured custom_role_data_source")
+}
+
+func (d *CustomRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataModels.CustomRoleModel
+
+	tflog.Trace(ctx, "Reading custom role data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read custom role configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	roles := listAllPages[dto.CustomRoleDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(d.clientConfiguration)
+		},
+		"/v1/roles",
+		map[string]string{},
+		"list custom roles",
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matches := make([]dto.CustomRoleDto, 0)
+	for _, role := range roles {
+		if role.Name == data.Name.ValueString() {
+			matches = append(matches, role)
+		}
+	}
+
+	if len(matches) == 0 {
+		tflog.Error(ctx, "No custom roles found")
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No custom role named %q found", data.Name.ValueString()))
+		return
+	} else if len(matches) > 1 {
+		ids := make([]string, len(matches))
+		for i, role := range matches {
+			ids[i] = role.ID
+		}
+		tflog.Error(ctx, fmt.Sprintf("Multiple custom roles found: %s", strings.Join(ids, ", ")))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Multiple custom roles named %q found (%s)", data.Name.ValueString(), strings.Join(ids, ", ")))
+		return
+	}
+
+	// The rights of the role are only guaranteed to be returned by the role itself
+	var customRoleDto dto.CustomRoleDto
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(d.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/roles/%s", matches[0].ID)).
+		Method(httpClient.GET).
+		SetBodyParseObject(&customRoleDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "read custom role", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP requests to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+	data = *CustomRoleDtoToModel(&customRoleDto)
+
+	tflog.Trace(ctx, "Successfully read custom role data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomRoleDataSource(t *testing.T) {
+	roleName := uuid.NewString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_custom_role" "example" {
+  name = "` + roleName + `"
+  granted_rights = [
+    "alert-acknowledge",
+    "alert-close"
+  ]
+  disallowed_rights = [
+    "alert-delete"
+  ]
+}
+
+data "atlassian-operations_custom_role" "test" {
+	depends_on = ["atlassian-operations_custom_role.example"]
+	name = "` + roleName + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the data source
+					// Verify all attributes are set
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_custom_role.test", "id", "atlassian-operations_custom_role.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_custom_role.test", "name", roleName),
+					resource.TestCheckResourceAttr("data.atlassian-operations_custom_role.test", "granted_rights.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.atlassian-operations_custom_role.test", "granted_rights.*", "alert-acknowledge"),
+					resource.TestCheckTypeSetElemAttr("data.atlassian-operations_custom_role.test", "granted_rights.*", "alert-close"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_custom_role.test", "disallowed_rights.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.atlassian-operations_custom_role.test", "disallowed_rights.*", "alert-delete"),
+				),
+			},
+		},
+	})
+}
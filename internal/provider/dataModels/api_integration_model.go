@@ -1,25 +1,25 @@
 package dataModels
 
 import (
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type (
 	ApiIntegrationModel struct {
-		Id                     types.String    `tfsdk:"id"`
-		Name                   types.String    `tfsdk:"name"`
-		ApiKey                 types.String    `tfsdk:"api_key"`
-		Type                   types.String    `tfsdk:"type"`
-		Enabled                types.Bool      `tfsdk:"enabled"`
-		TeamId                 types.String    `tfsdk:"team_id"`
-		Advanced               types.Bool      `tfsdk:"advanced"`
-		MaintenanceSources     types.List      `tfsdk:"maintenance_sources"`
-		Directions             types.List      `tfsdk:"directions"`
-		Domains                types.List      `tfsdk:"domains"`
-		TypeSpecificProperties jsontypes.Exact `tfsdk:"type_specific_properties"`
-		DeleteDefaultActions   types.Bool      `tfsdk:"delete_default_actions"`
+		Id                     types.String            `tfsdk:"id"`
+		Name                   types.String            `tfsdk:"name"`
+		ApiKey                 types.String            `tfsdk:"api_key"`
+		Type                   types.String            `tfsdk:"type"`
+		Enabled                types.Bool              `tfsdk:"enabled"`
+		TeamId                 types.String            `tfsdk:"team_id"`
+		Advanced               types.Bool              `tfsdk:"advanced"`
+		MaintenanceSources     types.List              `tfsdk:"maintenance_sources"`
+		Directions             types.List              `tfsdk:"directions"`
+		Domains                types.List              `tfsdk:"domains"`
+		TypeSpecificProperties customTypes.LenientJson `tfsdk:"type_specific_properties"`
+		DeleteDefaultActions   types.Bool              `tfsdk:"delete_default_actions"`
 	}
 )
 
@@ -34,7 +34,7 @@ var ApiIntegrationModelMap = map[string]attr.Type{
 	"maintenance_sources":      types.ListType{ElemType: types.ObjectType{AttrTypes: IntegrationMaintenanceSourcesResponseModelMap}},
 	"directions":               types.ListType{ElemType: types.StringType},
 	"domains":                  types.ListType{ElemType: types.StringType},
-	"type_specific_properties": jsontypes.ExactType{},
+	"type_specific_properties": customTypes.LenientJsonType{},
 	"delete_default_actions":   types.BoolType,
 }
 
@@ -0,0 +1,43 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type (
+	ChatIntegrationModel struct {
+		Id                     types.String `tfsdk:"id"`
+		Name                   types.String `tfsdk:"name"`
+		Enabled                types.Bool   `tfsdk:"enabled"`
+		TeamId                 types.String `tfsdk:"team_id"`
+		Advanced               types.Bool   `tfsdk:"advanced"`
+		Directions             types.List   `tfsdk:"directions"`
+		Domains                types.List   `tfsdk:"domains"`
+		MaintenanceSources     types.List   `tfsdk:"maintenance_sources"`
+		TypeSpecificProperties types.Object `tfsdk:"type_specific_properties"`
+	}
+
+	ChatIntegrationTypeSpecificPropertiesModel struct {
+		Workspace    types.String `tfsdk:"workspace"`
+		Channel      types.String `tfsdk:"channel"`
+		AlertActions types.Set    `tfsdk:"alert_actions"`
+		Filter       types.Object `tfsdk:"filter"`
+	}
+)
+
+var ChatIntegrationTypeSpecificPropertiesModelMap = map[string]attr.Type{
+	"workspace":     types.StringType,
+	"channel":       types.StringType,
+	"alert_actions": types.SetType{ElemType: types.StringType},
+	"filter":        types.ObjectType{AttrTypes: CriteriaModelMap},
+}
+
+func (receiver *ChatIntegrationTypeSpecificPropertiesModel) AsValue() types.Object {
+	return types.ObjectValueMust(ChatIntegrationTypeSpecificPropertiesModelMap, map[string]attr.Value{
+		"workspace":     receiver.Workspace,
+		"channel":       receiver.Channel,
+		"alert_actions": receiver.AlertActions,
+		"filter":        receiver.Filter,
+	})
+}
@@ -95,5 +95,11 @@ func (receiver *EscalationModel) AsValue() types.Object {
 		"description": receiver.Description,
 		"rules":       receiver.Rules,
 		"enabled":     receiver.Enabled,
+		"repeat":      receiver.Repeat,
 	})
 }
+
+type EscalationsDataSourceModel struct {
+	TeamId      types.String `tfsdk:"team_id"`
+	Escalations types.List   `tfsdk:"escalations"`
+}
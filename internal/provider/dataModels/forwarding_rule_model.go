@@ -0,0 +1,35 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ForwardingRuleModel struct {
+	ID         types.String `tfsdk:"id"`
+	Alias      types.String `tfsdk:"alias"`
+	FromUserID types.String `tfsdk:"from_user_id"`
+	To         types.Object `tfsdk:"to"`
+	StartDate  types.String `tfsdk:"start_date"`
+	EndDate    types.String `tfsdk:"end_date"`
+}
+
+var ForwardingRuleModelMap = map[string]attr.Type{
+	"id":           types.StringType,
+	"alias":        types.StringType,
+	"from_user_id": types.StringType,
+	"to":           types.ObjectType{AttrTypes: ResponderInfoModelMap},
+	"start_date":   types.StringType,
+	"end_date":     types.StringType,
+}
+
+func (receiver *ForwardingRuleModel) AsValue() types.Object {
+	return types.ObjectValueMust(ForwardingRuleModelMap, map[string]attr.Value{
+		"id":           receiver.ID,
+		"alias":        receiver.Alias,
+		"from_user_id": receiver.FromUserID,
+		"to":           receiver.To,
+		"start_date":   receiver.StartDate,
+		"end_date":     receiver.EndDate,
+	})
+}
@@ -1,6 +1,8 @@
 package dataModels
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,3 +19,55 @@ type HeartbeatModel struct {
 	AlertTags     types.Set    `tfsdk:"alert_tags"`
 	AlertPriority types.String `tfsdk:"alert_priority"`
 }
+
+type HeartbeatDataSourceModel struct {
+	Name          types.String      `tfsdk:"name"`
+	Description   types.String      `tfsdk:"description"`
+	Interval      types.Int64       `tfsdk:"interval"`
+	IntervalUnit  types.String      `tfsdk:"interval_unit"`
+	Enabled       types.Bool        `tfsdk:"enabled"`
+	Status        types.String      `tfsdk:"status"`
+	LastPingTime  timetypes.RFC3339 `tfsdk:"last_ping_time"`
+	Expired       types.Bool        `tfsdk:"expired"`
+	TeamID        types.String      `tfsdk:"team_id"`
+	AlertMessage  types.String      `tfsdk:"alert_message"`
+	AlertTags     types.Set         `tfsdk:"alert_tags"`
+	AlertPriority types.String      `tfsdk:"alert_priority"`
+}
+
+type HeartbeatsDataSourceModel struct {
+	TeamID     types.String `tfsdk:"team_id"`
+	Heartbeats types.List   `tfsdk:"heartbeats"`
+}
+
+var HeartbeatDataSourceModelMap = map[string]attr.Type{
+	"name":           types.StringType,
+	"description":    types.StringType,
+	"interval":       types.Int64Type,
+	"interval_unit":  types.StringType,
+	"enabled":        types.BoolType,
+	"status":         types.StringType,
+	"last_ping_time": timetypes.RFC3339Type{},
+	"expired":        types.BoolType,
+	"team_id":        types.StringType,
+	"alert_message":  types.StringType,
+	"alert_tags":     types.SetType{ElemType: types.StringType},
+	"alert_priority": types.StringType,
+}
+
+func (receiver *HeartbeatDataSourceModel) AsValue() types.Object {
+	return types.ObjectValueMust(HeartbeatDataSourceModelMap, map[string]attr.Value{
+		"name":           receiver.Name,
+		"description":    receiver.Description,
+		"interval":       receiver.Interval,
+		"interval_unit":  receiver.IntervalUnit,
+		"enabled":        receiver.Enabled,
+		"status":         receiver.Status,
+		"last_ping_time": receiver.LastPingTime,
+		"expired":        receiver.Expired,
+		"team_id":        receiver.TeamID,
+		"alert_message":  receiver.AlertMessage,
+		"alert_tags":     receiver.AlertTags,
+		"alert_priority": receiver.AlertPriority,
+	})
+}
@@ -0,0 +1,77 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IncomingCallRoutingModel struct {
+	ID           types.String `tfsdk:"id"`
+	TeamID       types.String `tfsdk:"team_id"`
+	Name         types.String `tfsdk:"name"`
+	PhoneNumber  types.String `tfsdk:"phone_number"`
+	Greeting     types.String `tfsdk:"greeting"`
+	RoutingRules types.List   `tfsdk:"routing_rules"`
+	Fallback     types.Object `tfsdk:"fallback"`
+}
+
+type IncomingCallRoutingRuleModel struct {
+	Name            types.String `tfsdk:"name"`
+	TimeRestriction types.Object `tfsdk:"time_restriction"`
+	Recipient       types.Object `tfsdk:"recipient"`
+}
+
+type IncomingCallRecipientModel struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+}
+
+var IncomingCallRoutingModelMap = map[string]attr.Type{
+	"id":           types.StringType,
+	"team_id":      types.StringType,
+	"name":         types.StringType,
+	"phone_number": types.StringType,
+	"greeting":     types.StringType,
+	"routing_rules": types.ListType{
+		ElemType: types.ObjectType{AttrTypes: IncomingCallRoutingRuleModelMap},
+	},
+	"fallback": types.ObjectType{AttrTypes: IncomingCallRecipientModelMap},
+}
+
+var IncomingCallRoutingRuleModelMap = map[string]attr.Type{
+	"name":             types.StringType,
+	"time_restriction": types.ObjectType{AttrTypes: TimeRestrictionModelMap},
+	"recipient":        types.ObjectType{AttrTypes: IncomingCallRecipientModelMap},
+}
+
+var IncomingCallRecipientModelMap = map[string]attr.Type{
+	"type": types.StringType,
+	"id":   types.StringType,
+}
+
+func (receiver *IncomingCallRoutingModel) AsValue() types.Object {
+	return types.ObjectValueMust(IncomingCallRoutingModelMap, map[string]attr.Value{
+		"id":            receiver.ID,
+		"team_id":       receiver.TeamID,
+		"name":          receiver.Name,
+		"phone_number":  receiver.PhoneNumber,
+		"greeting":      receiver.Greeting,
+		"routing_rules": receiver.RoutingRules,
+		"fallback":      receiver.Fallback,
+	})
+}
+
+func (receiver *IncomingCallRoutingRuleModel) AsValue() types.Object {
+	return types.ObjectValueMust(IncomingCallRoutingRuleModelMap, map[string]attr.Value{
+		"name":             receiver.Name,
+		"time_restriction": receiver.TimeRestriction,
+		"recipient":        receiver.Recipient,
+	})
+}
+
+func (receiver *IncomingCallRecipientModel) AsValue() types.Object {
+	return types.ObjectValueMust(IncomingCallRecipientModelMap, map[string]attr.Value{
+		"type": receiver.Type,
+		"id":   receiver.ID,
+	})
+}
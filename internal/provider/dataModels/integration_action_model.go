@@ -1,24 +1,24 @@
 package dataModels
 
 import (
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type IntegrationActionModel struct {
-	ID                     types.String    `tfsdk:"id"`
-	IntegrationID          types.String    `tfsdk:"integration_id"`
-	Type                   types.String    `tfsdk:"type"`
-	Name                   types.String    `tfsdk:"name"`
-	Domain                 types.String    `tfsdk:"domain"`
-	Direction              types.String    `tfsdk:"direction"`
-	GroupType              types.String    `tfsdk:"group_type"`
-	Filter                 types.Object    `tfsdk:"filter"`
-	TypeSpecificProperties jsontypes.Exact `tfsdk:"type_specific_properties"`
-	FieldMappings          jsontypes.Exact `tfsdk:"field_mappings"`
-	ActionMapping          types.Object    `tfsdk:"action_mapping"`
-	Enabled                types.Bool      `tfsdk:"enabled"`
+	ID                     types.String            `tfsdk:"id"`
+	IntegrationID          types.String            `tfsdk:"integration_id"`
+	Type                   types.String            `tfsdk:"type"`
+	Name                   types.String            `tfsdk:"name"`
+	Domain                 types.String            `tfsdk:"domain"`
+	Direction              types.String            `tfsdk:"direction"`
+	GroupType              types.String            `tfsdk:"group_type"`
+	Filter                 types.Object            `tfsdk:"filter"`
+	TypeSpecificProperties customTypes.LenientJson `tfsdk:"type_specific_properties"`
+	FieldMappings          customTypes.LenientJson `tfsdk:"field_mappings"`
+	ActionMapping          types.Object            `tfsdk:"action_mapping"`
+	Enabled                types.Bool              `tfsdk:"enabled"`
 }
 
 type FilterModel struct {
@@ -38,8 +38,8 @@ type FilterConditionModel struct {
 }
 
 type ActionMappingModel struct {
-	Type      types.String    `tfsdk:"type"`
-	Parameter jsontypes.Exact `tfsdk:"parameter"`
+	Type      types.String            `tfsdk:"type"`
+	Parameter customTypes.LenientJson `tfsdk:"parameter"`
 }
 
 var FilterConditionModelMap = map[string]attr.Type{
@@ -60,7 +60,7 @@ var FilterModelMap = map[string]attr.Type{
 
 var ActionMappingModelMap = map[string]attr.Type{
 	"type":      types.StringType,
-	"parameter": jsontypes.ExactType{},
+	"parameter": customTypes.LenientJsonType{},
 }
 
 var IntegrationActionModelMap = map[string]attr.Type{
@@ -72,8 +72,8 @@ var IntegrationActionModelMap = map[string]attr.Type{
 	"direction":                types.StringType,
 	"group_type":               types.StringType,
 	"filter":                   types.ObjectType{AttrTypes: FilterModelMap},
-	"type_specific_properties": jsontypes.ExactType{},
-	"field_mappings":           jsontypes.ExactType{},
+	"type_specific_properties": customTypes.LenientJsonType{},
+	"field_mappings":           customTypes.LenientJsonType{},
 	"action_mapping":           types.ObjectType{AttrTypes: ActionMappingModelMap},
 	"enabled":                  types.BoolType,
 }
@@ -0,0 +1,57 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type (
+	IntegrationModel struct {
+		Id                     types.String    `tfsdk:"id"`
+		Name                   types.String    `tfsdk:"name"`
+		Type                   types.String    `tfsdk:"type"`
+		Enabled                types.Bool      `tfsdk:"enabled"`
+		TeamId                 types.String    `tfsdk:"team_id"`
+		Advanced               types.Bool      `tfsdk:"advanced"`
+		MaintenanceSources     types.List      `tfsdk:"maintenance_sources"`
+		Directions             types.List      `tfsdk:"directions"`
+		Domains                types.List      `tfsdk:"domains"`
+		TypeSpecificProperties jsontypes.Exact `tfsdk:"type_specific_properties"`
+	}
+
+	IntegrationsDataSourceModel struct {
+		Type         types.String `tfsdk:"type"`
+		TeamId       types.String `tfsdk:"team_id"`
+		Enabled      types.Bool   `tfsdk:"enabled"`
+		Integrations types.List   `tfsdk:"integrations"`
+	}
+)
+
+var IntegrationModelMap = map[string]attr.Type{
+	"id":                       types.StringType,
+	"name":                     types.StringType,
+	"type":                     types.StringType,
+	"enabled":                  types.BoolType,
+	"team_id":                  types.StringType,
+	"advanced":                 types.BoolType,
+	"maintenance_sources":      types.ListType{ElemType: types.ObjectType{AttrTypes: IntegrationMaintenanceSourcesResponseModelMap}},
+	"directions":               types.ListType{ElemType: types.StringType},
+	"domains":                  types.ListType{ElemType: types.StringType},
+	"type_specific_properties": jsontypes.ExactType{},
+}
+
+func (receiver *IntegrationModel) AsValue() types.Object {
+	return types.ObjectValueMust(IntegrationModelMap, map[string]attr.Value{
+		"id":                       receiver.Id,
+		"name":                     receiver.Name,
+		"type":                     receiver.Type,
+		"enabled":                  receiver.Enabled,
+		"team_id":                  receiver.TeamId,
+		"advanced":                 receiver.Advanced,
+		"maintenance_sources":      receiver.MaintenanceSources,
+		"directions":               receiver.Directions,
+		"domains":                  receiver.Domains,
+		"type_specific_properties": receiver.TypeSpecificProperties,
+	})
+}
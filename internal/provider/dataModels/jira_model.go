@@ -0,0 +1,20 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type (
+	GroupModel struct {
+		GroupId types.String `tfsdk:"group_id"`
+		Name    types.String `tfsdk:"name"`
+	}
+	ProjectModel struct {
+		Id             types.String `tfsdk:"id"`
+		Key            types.String `tfsdk:"key"`
+		Name           types.String `tfsdk:"name"`
+		Description    types.String `tfsdk:"description"`
+		ProjectTypeKey types.String `tfsdk:"project_type_key"`
+		Self           types.String `tfsdk:"self"`
+	}
+)
@@ -14,6 +14,27 @@ type MaintenanceModel struct {
 	Status      types.String `tfsdk:"status"`
 	TeamID      types.String `tfsdk:"team_id"`
 	Rules       types.List   `tfsdk:"rules"`
+	Recurrence  types.Object `tfsdk:"recurrence"`
+	Occurrences types.List   `tfsdk:"occurrences"`
+}
+
+// MaintenanceRecurrenceModel represents the schedule of a recurring maintenance window
+type MaintenanceRecurrenceModel struct {
+	Frequency           types.String `tfsdk:"frequency"`
+	DaysOfWeek          types.Set    `tfsdk:"days_of_week"`
+	DaysOfMonth         types.Set    `tfsdk:"days_of_month"`
+	StartTime           types.String `tfsdk:"start_time"`
+	DurationMinutes     types.Int64  `tfsdk:"duration_minutes"`
+	Timezone            types.String `tfsdk:"timezone"`
+	Until               types.String `tfsdk:"until"`
+	UpcomingOccurrences types.Int64  `tfsdk:"upcoming_occurrences"`
+}
+
+// MaintenanceOccurrenceModel represents a maintenance window generated for a recurring maintenance window
+type MaintenanceOccurrenceModel struct {
+	ID        types.String `tfsdk:"id"`
+	StartDate types.String `tfsdk:"start_date"`
+	EndDate   types.String `tfsdk:"end_date"`
 }
 
 // MaintenanceRuleModel represents a rule within a maintenance window for Terraform
@@ -48,3 +69,58 @@ var MaintenanceRuleObjectType = types.ObjectType{
 		},
 	},
 }
+
+var MaintenanceRecurrenceModelMap = map[string]attr.Type{
+	"frequency":            types.StringType,
+	"days_of_week":         types.SetType{ElemType: types.StringType},
+	"days_of_month":        types.SetType{ElemType: types.Int64Type},
+	"start_time":           types.StringType,
+	"duration_minutes":     types.Int64Type,
+	"timezone":             types.StringType,
+	"until":                types.StringType,
+	"upcoming_occurrences": types.Int64Type,
+}
+
+var MaintenanceOccurrenceModelMap = map[string]attr.Type{
+	"id":         types.StringType,
+	"start_date": types.StringType,
+	"end_date":   types.StringType,
+}
+
+func (receiver *MaintenanceOccurrenceModel) AsValue() types.Object {
+	return types.ObjectValueMust(MaintenanceOccurrenceModelMap, map[string]attr.Value{
+		"id":         receiver.ID,
+		"start_date": receiver.StartDate,
+		"end_date":   receiver.EndDate,
+	})
+}
+
+type MaintenancesDataSourceModel struct {
+	Type         types.String `tfsdk:"type"`
+	TeamID       types.String `tfsdk:"team_id"`
+	EntityID     types.String `tfsdk:"entity_id"`
+	Maintenances types.List   `tfsdk:"maintenances"`
+}
+
+var MaintenanceModelMap = map[string]attr.Type{
+	"id":          types.StringType,
+	"description": types.StringType,
+	"start_date":  types.StringType,
+	"end_date":    types.StringType,
+	"status":      types.StringType,
+	"team_id":     types.StringType,
+	"rules":       types.ListType{ElemType: MaintenanceRuleObjectType},
+}
+
+// AsValue returns the maintenance window as listed by the maintenances data source, which has no recurrence
+func (receiver *MaintenanceModel) AsValue() types.Object {
+	return types.ObjectValueMust(MaintenanceModelMap, map[string]attr.Value{
+		"id":          receiver.ID,
+		"description": receiver.Description,
+		"start_date":  receiver.StartDate,
+		"end_date":    receiver.EndDate,
+		"status":      receiver.Status,
+		"team_id":     receiver.TeamID,
+		"rules":       receiver.Rules,
+	})
+}
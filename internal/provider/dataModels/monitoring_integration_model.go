@@ -0,0 +1,19 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type MonitoringIntegrationModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	ApiKey                 types.String `tfsdk:"api_key"`
+	WebhookUrl             types.String `tfsdk:"webhook_url"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	TeamId                 types.String `tfsdk:"team_id"`
+	Advanced               types.Bool   `tfsdk:"advanced"`
+	Directions             types.List   `tfsdk:"directions"`
+	Domains                types.List   `tfsdk:"domains"`
+	MaintenanceSources     types.List   `tfsdk:"maintenance_sources"`
+	TypeSpecificProperties types.Object `tfsdk:"type_specific_properties"`
+}
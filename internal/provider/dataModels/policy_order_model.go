@@ -0,0 +1,28 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PolicyOrderModel struct {
+	ID        types.String `tfsdk:"id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	PolicyIDs types.List   `tfsdk:"policy_ids"`
+}
+
+var PolicyOrderModelMap = map[string]attr.Type{
+	"id":      types.StringType,
+	"team_id": types.StringType,
+	"policy_ids": types.ListType{
+		ElemType: types.StringType,
+	},
+}
+
+func (receiver *PolicyOrderModel) AsValue() types.Object {
+	return types.ObjectValueMust(PolicyOrderModelMap, map[string]attr.Value{
+		"id":         receiver.ID,
+		"team_id":    receiver.TeamID,
+		"policy_ids": receiver.PolicyIDs,
+	})
+}
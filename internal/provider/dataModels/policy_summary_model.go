@@ -0,0 +1,78 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PolicySummaryModel is the summary of an alert policy, as returned by the policy list endpoints
+type PolicySummaryModel struct {
+	ID      types.String `tfsdk:"id"`
+	TeamID  types.String `tfsdk:"team_id"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Order   types.Int64  `tfsdk:"order"`
+}
+
+// NotificationPolicySummaryModel is the summary of a notification policy, whose order is fractional
+type NotificationPolicySummaryModel struct {
+	ID      types.String  `tfsdk:"id"`
+	TeamID  types.String  `tfsdk:"team_id"`
+	Name    types.String  `tfsdk:"name"`
+	Type    types.String  `tfsdk:"type"`
+	Enabled types.Bool    `tfsdk:"enabled"`
+	Order   types.Float64 `tfsdk:"order"`
+}
+
+type AlertPoliciesDataSourceModel struct {
+	TeamID        types.String `tfsdk:"team_id"`
+	NameRegex     types.String `tfsdk:"name_regex"`
+	AlertPolicies types.List   `tfsdk:"alert_policies"`
+}
+
+type NotificationPoliciesDataSourceModel struct {
+	TeamID               types.String `tfsdk:"team_id"`
+	NameRegex            types.String `tfsdk:"name_regex"`
+	NotificationPolicies types.List   `tfsdk:"notification_policies"`
+}
+
+var PolicySummaryModelMap = map[string]attr.Type{
+	"id":      types.StringType,
+	"team_id": types.StringType,
+	"name":    types.StringType,
+	"type":    types.StringType,
+	"enabled": types.BoolType,
+	"order":   types.Int64Type,
+}
+
+func (receiver *PolicySummaryModel) AsValue() types.Object {
+	return types.ObjectValueMust(PolicySummaryModelMap, map[string]attr.Value{
+		"id":      receiver.ID,
+		"team_id": receiver.TeamID,
+		"name":    receiver.Name,
+		"type":    receiver.Type,
+		"enabled": receiver.Enabled,
+		"order":   receiver.Order,
+	})
+}
+
+var NotificationPolicySummaryModelMap = map[string]attr.Type{
+	"id":      types.StringType,
+	"team_id": types.StringType,
+	"name":    types.StringType,
+	"type":    types.StringType,
+	"enabled": types.BoolType,
+	"order":   types.Float64Type,
+}
+
+func (receiver *NotificationPolicySummaryModel) AsValue() types.Object {
+	return types.ObjectValueMust(NotificationPolicySummaryModelMap, map[string]attr.Value{
+		"id":      receiver.ID,
+		"team_id": receiver.TeamID,
+		"name":    receiver.Name,
+		"type":    receiver.Type,
+		"enabled": receiver.Enabled,
+		"order":   receiver.Order,
+	})
+}
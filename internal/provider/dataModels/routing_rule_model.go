@@ -65,3 +65,8 @@ func (receiver *RoutingRuleModel) AsValue() types.Object {
 		"notify":           receiver.Notify,
 	})
 }
+
+type RoutingRulesDataSourceModel struct {
+	TeamID       types.String `tfsdk:"team_id"`
+	RoutingRules types.List   `tfsdk:"routing_rules"`
+}
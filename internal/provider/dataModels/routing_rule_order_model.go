@@ -0,0 +1,28 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RoutingRuleOrderModel struct {
+	ID      types.String `tfsdk:"id"`
+	TeamID  types.String `tfsdk:"team_id"`
+	RuleIDs types.List   `tfsdk:"rule_ids"`
+}
+
+var RoutingRuleOrderModelMap = map[string]attr.Type{
+	"id":      types.StringType,
+	"team_id": types.StringType,
+	"rule_ids": types.ListType{
+		ElemType: types.StringType,
+	},
+}
+
+func (receiver *RoutingRuleOrderModel) AsValue() types.Object {
+	return types.ObjectValueMust(RoutingRuleOrderModelMap, map[string]attr.Value{
+		"id":       receiver.ID,
+		"team_id":  receiver.TeamID,
+		"rule_ids": receiver.RuleIDs,
+	})
+}
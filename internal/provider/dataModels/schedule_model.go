@@ -33,3 +33,21 @@ func (receiver *ScheduleModel) AsValue() types.Object {
 		"team_id":     receiver.TeamId,
 	})
 }
+
+type ScheduleDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	IgnoreCase  types.Bool   `tfsdk:"ignore_case"`
+	Description types.String `tfsdk:"description"`
+	Timezone    types.String `tfsdk:"timezone"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	TeamId      types.String `tfsdk:"team_id"`
+	Rotations   types.List   `tfsdk:"rotations"`
+}
+
+type SchedulesDataSourceModel struct {
+	TeamId    types.String `tfsdk:"team_id"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Schedules types.List   `tfsdk:"schedules"`
+}
@@ -0,0 +1,39 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ScheduleOverrideModel struct {
+	Id          types.String      `tfsdk:"id"`
+	ScheduleId  types.String      `tfsdk:"schedule_id"`
+	Alias       types.String      `tfsdk:"alias"`
+	Responder   types.Object      `tfsdk:"responder"`
+	StartDate   timetypes.RFC3339 `tfsdk:"start_date"`
+	EndDate     timetypes.RFC3339 `tfsdk:"end_date"`
+	RotationIds types.Set         `tfsdk:"rotation_ids"`
+}
+
+var ScheduleOverrideModelMap = map[string]attr.Type{
+	"id":           types.StringType,
+	"schedule_id":  types.StringType,
+	"alias":        types.StringType,
+	"responder":    types.ObjectType{AttrTypes: ResponderInfoModelMap},
+	"start_date":   timetypes.RFC3339Type{},
+	"end_date":     timetypes.RFC3339Type{},
+	"rotation_ids": types.SetType{ElemType: types.StringType},
+}
+
+func (receiver *ScheduleOverrideModel) AsValue() types.Object {
+	return types.ObjectValueMust(ScheduleOverrideModelMap, map[string]attr.Value{
+		"id":           receiver.Id,
+		"schedule_id":  receiver.ScheduleId,
+		"alias":        receiver.Alias,
+		"responder":    receiver.Responder,
+		"start_date":   receiver.StartDate,
+		"end_date":     receiver.EndDate,
+		"rotation_ids": receiver.RotationIds,
+	})
+}
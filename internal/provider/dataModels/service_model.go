@@ -79,3 +79,10 @@ func (m *ServiceModel) AsValue() types.Object {
 		"projects":         m.Projects,
 	})
 }
+
+type ServicesDataSourceModel struct {
+	Tier     types.Int32  `tfsdk:"tier"`
+	Type     types.String `tfsdk:"type"`
+	Owner    types.String `tfsdk:"owner"`
+	Services types.List   `tfsdk:"services"`
+}
@@ -0,0 +1,31 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamDefaultRoutingRuleModel struct {
+	ID     types.String `tfsdk:"id"`
+	TeamID types.String `tfsdk:"team_id"`
+	Name   types.String `tfsdk:"name"`
+	Notify types.Object `tfsdk:"notify"`
+}
+
+var TeamDefaultRoutingRuleModelMap = map[string]attr.Type{
+	"id":      types.StringType,
+	"team_id": types.StringType,
+	"name":    types.StringType,
+	"notify": types.ObjectType{
+		AttrTypes: RoutingRuleNotifyModelMap,
+	},
+}
+
+func (receiver *TeamDefaultRoutingRuleModel) AsValue() types.Object {
+	return types.ObjectValueMust(TeamDefaultRoutingRuleModelMap, map[string]attr.Value{
+		"id":      receiver.ID,
+		"team_id": receiver.TeamID,
+		"name":    receiver.Name,
+		"notify":  receiver.Notify,
+	})
+}
@@ -0,0 +1,32 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamMembershipModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	TeamId         types.String `tfsdk:"team_id"`
+	AccountId      types.String `tfsdk:"account_id"`
+	Role           types.String `tfsdk:"role"`
+}
+
+var TeamMembershipModelMap = map[string]attr.Type{
+	"id":              types.StringType,
+	"organization_id": types.StringType,
+	"team_id":         types.StringType,
+	"account_id":      types.StringType,
+	"role":            types.StringType,
+}
+
+func (receiver *TeamMembershipModel) AsValue() types.Object {
+	return types.ObjectValueMust(TeamMembershipModelMap, map[string]attr.Value{
+		"id":              receiver.Id,
+		"organization_id": receiver.OrganizationId,
+		"team_id":         receiver.TeamId,
+		"account_id":      receiver.AccountId,
+		"role":            receiver.Role,
+	})
+}
@@ -15,7 +15,27 @@ type (
 		TeamType               types.String `tfsdk:"team_type"`
 		UserPermissions        types.Object `tfsdk:"user_permissions"`
 		Member                 types.Set    `tfsdk:"member"`
+		AdminAccountIds        types.Set    `tfsdk:"admin_account_ids"`
 		DeleteDefaultResources types.Bool   `tfsdk:"delete_default_resources"`
+		ManageMembers          types.Bool   `tfsdk:"manage_members"`
+		DefaultScheduleId      types.String `tfsdk:"default_schedule_id"`
+		DefaultEscalationId    types.String `tfsdk:"default_escalation_id"`
+		DefaultRoutingRuleId   types.String `tfsdk:"default_routing_rule_id"`
+	}
+	TeamDataSourceModel struct {
+		Description            types.String `tfsdk:"description"`
+		DisplayName            types.String `tfsdk:"display_name"`
+		OrganizationId         types.String `tfsdk:"organization_id"`
+		Id                     types.String `tfsdk:"id"`
+		SiteId                 types.String `tfsdk:"site_id"`
+		TeamType               types.String `tfsdk:"team_type"`
+		UserPermissions        types.Object `tfsdk:"user_permissions"`
+		Member                 types.Set    `tfsdk:"member"`
+		DeleteDefaultResources types.Bool   `tfsdk:"delete_default_resources"`
+		ManageMembers          types.Bool   `tfsdk:"manage_members"`
+		DefaultScheduleId      types.String `tfsdk:"default_schedule_id"`
+		DefaultEscalationId    types.String `tfsdk:"default_escalation_id"`
+		DefaultRoutingRuleId   types.String `tfsdk:"default_routing_rule_id"`
 	}
 	PublicApiUserPermissionsModel struct {
 		AddMembers    types.Bool `tfsdk:"add_members"`
@@ -0,0 +1,29 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamOpsEnablementModel struct {
+	Id              types.String `tfsdk:"id"`
+	OrganizationId  types.String `tfsdk:"organization_id"`
+	TeamId          types.String `tfsdk:"team_id"`
+	AdminAccountIds types.Set    `tfsdk:"admin_account_ids"`
+}
+
+var TeamOpsEnablementModelMap = map[string]attr.Type{
+	"id":                types.StringType,
+	"organization_id":   types.StringType,
+	"team_id":           types.StringType,
+	"admin_account_ids": types.SetType{ElemType: types.StringType},
+}
+
+func (receiver *TeamOpsEnablementModel) AsValue() types.Object {
+	return types.ObjectValueMust(TeamOpsEnablementModelMap, map[string]attr.Value{
+		"id":                receiver.Id,
+		"organization_id":   receiver.OrganizationId,
+		"team_id":           receiver.TeamId,
+		"admin_account_ids": receiver.AdminAccountIds,
+	})
+}
@@ -27,3 +27,9 @@ func (receiver *UserContactModel) AsValue() types.Object {
 		"enabled": receiver.Enabled,
 	})
 }
+
+type UserContactsDataSourceModel struct {
+	Method   types.String `tfsdk:"method"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Contacts types.List   `tfsdk:"contacts"`
+}
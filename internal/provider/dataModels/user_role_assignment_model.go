@@ -0,0 +1,29 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserRoleAssignmentModel struct {
+	ID           types.String `tfsdk:"id"`
+	AccountID    types.String `tfsdk:"account_id"`
+	Role         types.String `tfsdk:"role"`
+	PreviousRole types.String `tfsdk:"previous_role"`
+}
+
+var UserRoleAssignmentModelMap = map[string]attr.Type{
+	"id":            types.StringType,
+	"account_id":    types.StringType,
+	"role":          types.StringType,
+	"previous_role": types.StringType,
+}
+
+func (receiver *UserRoleAssignmentModel) AsValue() types.Object {
+	return types.ObjectValueMust(UserRoleAssignmentModelMap, map[string]attr.Value{
+		"id":            receiver.ID,
+		"account_id":    receiver.AccountID,
+		"role":          receiver.Role,
+		"previous_role": receiver.PreviousRole,
+	})
+}
@@ -0,0 +1,43 @@
+package dataModels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type (
+	WebhookIntegrationModel struct {
+		Id                     types.String `tfsdk:"id"`
+		Name                   types.String `tfsdk:"name"`
+		Enabled                types.Bool   `tfsdk:"enabled"`
+		TeamId                 types.String `tfsdk:"team_id"`
+		Advanced               types.Bool   `tfsdk:"advanced"`
+		Directions             types.List   `tfsdk:"directions"`
+		Domains                types.List   `tfsdk:"domains"`
+		MaintenanceSources     types.List   `tfsdk:"maintenance_sources"`
+		TypeSpecificProperties types.Object `tfsdk:"type_specific_properties"`
+	}
+
+	WebhookIntegrationTypeSpecificPropertiesModel struct {
+		Url                 types.String `tfsdk:"url"`
+		Headers             types.Map    `tfsdk:"headers"`
+		AddAlertDescription types.Bool   `tfsdk:"add_alert_description"`
+		AddAlertDetails     types.Bool   `tfsdk:"add_alert_details"`
+	}
+)
+
+var WebhookIntegrationTypeSpecificPropertiesModelMap = map[string]attr.Type{
+	"url":                   types.StringType,
+	"headers":               types.MapType{ElemType: types.StringType},
+	"add_alert_description": types.BoolType,
+	"add_alert_details":     types.BoolType,
+}
+
+func (receiver *WebhookIntegrationTypeSpecificPropertiesModel) AsValue() types.Object {
+	return types.ObjectValueMust(WebhookIntegrationTypeSpecificPropertiesModelMap, map[string]attr.Value{
+		"url":                   receiver.Url,
+		"headers":               receiver.Headers,
+		"add_alert_description": receiver.AddAlertDescription,
+		"add_alert_details":     receiver.AddAlertDetails,
+	})
+}
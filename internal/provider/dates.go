@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateDateRange checks that the dates are in RFC3339 format and that the end date is after the start date.
+// Dates that are null or not known yet are not checked.
+func validateDateRange(startDate types.String, endDate types.String, startPath path.Path, endPath path.Path, diags *diag.Diagnostics) {
+	start, startOk := parseConfiguredDate(startDate, startPath, diags)
+	end, endOk := parseConfiguredDate(endDate, endPath, diags)
+
+	if startOk && endOk && !end.After(start) {
+		diags.AddAttributeError(endPath, "Invalid Date Range",
+			fmt.Sprintf("The end date %s must be after the start date %s", endDate.ValueString(), startDate.ValueString()))
+	}
+}
+
+func parseConfiguredDate(date types.String, datePath path.Path, diags *diag.Diagnostics) (time.Time, bool) {
+	if date.IsNull() || date.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	parsed, err := time.Parse(time.RFC3339, date.ValueString())
+	if err != nil {
+		diags.AddAttributeError(datePath, "Invalid Date",
+			fmt.Sprintf("Expected a date in RFC3339 format (e.g., 2024-01-01T00:00:00Z), got: %q", date.ValueString()))
+		return time.Time{}, false
+	}
+
+	return parsed, true
+}
+
+// keepConfiguredDate returns the configured date if the API returned the same point in time in another format, such
+// as with milliseconds or in another timezone, so that the formatting does not show up as a difference.
+func keepConfiguredDate(configured types.String, returned string) types.String {
+	if returned == "" {
+		return types.StringNull()
+	}
+
+	configuredTime, err := time.Parse(time.RFC3339, configured.ValueString())
+	if err != nil {
+		return types.StringValue(returned)
+	}
+	returnedTime, err := time.Parse(time.RFC3339, returned)
+	if err != nil || !configuredTime.Equal(returnedTime) {
+		return types.StringValue(returned)
+	}
+
+	return configured
+}
+
+// hasDatePassed reports whether an RFC3339 date lies in the past. Dates that can not be parsed have not passed.
+func hasDatePassed(date string) bool {
+	parsed, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return false
+	}
+
+	return !parsed.After(time.Now())
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &EscalationDataSource{}
+	_ datasource.DataSourceWithConfigure = &EscalationDataSource{}
+)
+
+func NewEscalationDataSource() datasource.DataSource {
+	return &EscalationDataSource{}
+}
+
+// EscalationDataSource defines the data source implementation.
+type EscalationDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *EscalationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_escalation"
+}
+
+func (d *EscalationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Escalation data source",
+		Attributes:          schemaAttributes.EscalationDataSourceAttributes,
+	}
+}
+
+func (d *EscalationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring escalation_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure escalation_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured escalation_data_source")
+}
+
+func (d *EscalationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.EscalationModel
+
+	tflog.Trace(ctx, "Reading escalation data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read escalation configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	escalations := listAllPages[dto.EscalationDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(d.clientConfiguration)
+		},
+		fmt.Sprintf("/v1/teams/%s/escalations", model.TeamId.ValueString()),
+		map[string]string{},
+		"read escalation",
+		&resp.Diagnostics,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var escalation *dto.EscalationDto
+	for i := range escalations {
+		if escalations[i].Name == model.Name.ValueString() {
+			escalation = &escalations[i]
+			break
+		}
+	}
+
+	if escalation == nil {
+		tflog.Error(ctx, "No escalations found")
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("No escalation named %q found in team %s", model.Name.ValueString(), model.TeamId.ValueString()))
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP requests to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+	model = EscalationDtoToModel(model.TeamId.ValueString(), *escalation)
+
+	tflog.Trace(ctx, "Successfully read escalation data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
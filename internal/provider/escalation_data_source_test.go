@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEscalationDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	escalationName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  delete_default_resources = true
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_escalation" "example" {
+  name    = "` + escalationName + `"
+  team_id = atlassian-operations_team.example.id
+  description = "escalation description"
+  rules = [{
+	condition = "if-not-acked"
+	notify_type = "default"
+    delay = 5
+    recipient = {
+    	id = data.atlassian-operations_user.test1.account_id
+		type = "user"
+    }
+  }]
+  repeat = {
+  	wait_interval = 5
+    count = 10
+    reset_recipient_states = true
+    close_alert_after_all = true
+  }
+}
+
+resource "atlassian-operations_escalation" "similar" {
+  name    = "` + escalationName + `-secondary"
+  team_id = atlassian-operations_team.example.id
+  rules = [{
+	condition = "if-not-closed"
+	notify_type = "all"
+    delay = 1
+    recipient = {
+		id = atlassian-operations_team.example.id
+		type = "team"
+    }
+  }]
+}
+
+data "atlassian-operations_escalation" "test" {
+	depends_on = ["atlassian-operations_escalation.example", "atlassian-operations_escalation.similar"]
+	team_id = atlassian-operations_team.example.id
+	name = "` + escalationName + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.test", "id", "atlassian-operations_escalation.example", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_escalation.test", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_escalation.test", "description", "escalation description"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_escalation.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_escalation.test", "rules.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.atlassian-operations_escalation.test", "rules.*", map[string]string{
+						"condition":   "if-not-acked",
+						"notify_type": "default",
+						"delay":       "5",
+					}),
+					resource.TestCheckResourceAttr("data.atlassian-operations_escalation.test", "repeat.wait_interval", "5"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_escalation.test", "repeat.count", "10"),
+				),
+			},
+		},
+	})
+}
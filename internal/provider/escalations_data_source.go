@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &EscalationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &EscalationsDataSource{}
+)
+
+func NewEscalationsDataSource() datasource.DataSource {
+	return &EscalationsDataSource{}
+}
+
+// EscalationsDataSource defines the data source implementation.
+type EscalationsDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *EscalationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_escalations"
+}
+
+func (d *EscalationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Escalations data source",
+		Attributes:          schemaAttributes.EscalationsDataSourceAttributes,
+	}
+}
+
+func (d *EscalationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring escalations_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure escalations_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured escalations_data_source")
+}
+
+func (d *EscalationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.EscalationsDataSourceModel
+
+	tflog.Trace(ctx, "Reading escalations data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read escalations configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	escalations := listAllPages[dto.EscalationDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(d.clientConfiguration)
+		},
+		fmt.Sprintf("/v1/teams/%s/escalations", model.TeamId.ValueString()),
+		map[string]string{},
+		"list escalations",
+		&resp.Diagnostics,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP requests to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+
+	escalationValues := make([]attr.Value, len(escalations))
+	for i, escalation := range escalations {
+		escalationModel := EscalationDtoToModel(model.TeamId.ValueString(), escalation)
+		escalationValues[i] = escalationModel.AsValue()
+	}
+	model.Escalations = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.EscalationModelMap}, escalationValues)
+
+	tflog.Trace(ctx, "Successfully read escalations data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
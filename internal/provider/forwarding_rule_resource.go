@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &ForwardingRuleResource{}
+	_ resource.ResourceWithConfigure      = &ForwardingRuleResource{}
+	_ resource.ResourceWithImportState    = &ForwardingRuleResource{}
+	_ resource.ResourceWithValidateConfig = &ForwardingRuleResource{}
+)
+
+// ForwardingRuleResource defines the resource implementation for notification forwarding rules
+type ForwardingRuleResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewForwardingRuleResource() resource.Resource {
+	return &ForwardingRuleResource{}
+}
+
+// Metadata returns metadata for the resource
+func (r *ForwardingRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forwarding_rule"
+}
+
+// Schema defines the schema for the resource
+func (r *ForwardingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forward the notifications of a user to another user or a team for a period of time.",
+		Attributes:  schemaAttributes.ForwardingRuleResourceAttributes,
+	}
+}
+
+// Configure sets up the resource with provider configuration
+func (r *ForwardingRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring ForwardingRuleResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dto.JsmopsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured ForwardingRuleResource")
+}
+
+// ValidateConfig checks that the forwarding ends after it starts
+func (r *ForwardingRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dataModels.ForwardingRuleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateDateRange(config.StartDate, config.EndDate, path.Root("start_date"), path.Root("end_date"), &resp.Diagnostics)
+}
+
+// Create handles the create operation for the resource
+func (r *ForwardingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating ForwardingRuleResource")
+
+	var plan dataModels.ForwardingRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleDto := ForwardingRuleModelToDto(ctx, plan)
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl("/v1/forwarding-rules").
+		Method(httpClient.POST).
+		SetBody(ruleDto).
+		SetBodyParseObject(&ruleDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "create forwarding rule", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := ForwardingRuleDtoToModel(ruleDto)
+	result.StartDate = keepConfiguredDate(plan.StartDate, ruleDto.StartDate)
+	result.EndDate = keepConfiguredDate(plan.EndDate, ruleDto.EndDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	tflog.Trace(ctx, "Created ForwardingRuleResource")
+}
+
+// Read handles the read operation for the resource
+func (r *ForwardingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataModels.ForwardingRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Reading ForwardingRuleResource")
+
+	var ruleDto dto.ForwardingRuleDto
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/forwarding-rules/%s", state.ID.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&ruleDto).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	handleHttpResponse(httpResp, err, "read forwarding rule", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := ForwardingRuleDtoToModel(ruleDto)
+	result.StartDate = keepConfiguredDate(state.StartDate, ruleDto.StartDate)
+	result.EndDate = keepConfiguredDate(state.EndDate, ruleDto.EndDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+// Update handles the update operation for the resource
+func (r *ForwardingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataModels.ForwardingRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating ForwardingRuleResource")
+
+	ruleDto := ForwardingRuleModelToDto(ctx, plan)
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/forwarding-rules/%s", plan.ID.ValueString())).
+		Method(httpClient.PATCH).
+		SetBody(ruleDto).
+		SetBodyParseObject(&ruleDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update forwarding rule", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := ForwardingRuleDtoToModel(ruleDto)
+	result.StartDate = keepConfiguredDate(plan.StartDate, ruleDto.StartDate)
+	result.EndDate = keepConfiguredDate(plan.EndDate, ruleDto.EndDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	tflog.Trace(ctx, "Updated ForwardingRuleResource")
+}
+
+// Delete handles the delete operation for the resource
+func (r *ForwardingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataModels.ForwardingRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting ForwardingRuleResource")
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/forwarding-rules/%s", state.ID.ValueString())).
+		Method(httpClient.DELETE).
+		Send()
+
+	// The forwarding rule may have been removed in the meantime
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return
+	}
+
+	handleHttpResponse(httpResp, err, "delete forwarding rule", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted ForwardingRuleResource")
+}
+
+// ImportState imports a forwarding rule by its ID
+func (r *ForwardingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccForwardingRuleResource(t *testing.T) {
+	teamName := uuid.NewString()
+	alias := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+	emailSecondary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_SECONDARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+			if emailSecondary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_SECONDARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+data "atlassian-operations_user" "test2" {
+	email_address = "` + emailSecondary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    },
+    {
+      account_id = data.atlassian-operations_user.test2.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_forwarding_rule" "example" {
+  alias        = "` + alias + `"
+  from_user_id = data.atlassian-operations_user.test1.account_id
+  to = {
+    type = "user"
+    id   = data.atlassian-operations_user.test2.account_id
+  }
+  start_date = "2030-01-01T00:00:00Z"
+  end_date   = "2030-01-15T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("atlassian-operations_forwarding_rule.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_forwarding_rule.example", "alias", alias),
+					resource.TestCheckResourceAttrPair("atlassian-operations_forwarding_rule.example", "from_user_id", "data.atlassian-operations_user.test1", "account_id"),
+					resource.TestCheckResourceAttr("atlassian-operations_forwarding_rule.example", "to.type", "user"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_forwarding_rule.example", "to.id", "data.atlassian-operations_user.test2", "account_id"),
+					resource.TestCheckResourceAttr("atlassian-operations_forwarding_rule.example", "start_date", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("atlassian-operations_forwarding_rule.example", "end_date", "2030-01-15T00:00:00Z"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_forwarding_rule.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+data "atlassian-operations_user" "test2" {
+	email_address = "` + emailSecondary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    },
+    {
+      account_id = data.atlassian-operations_user.test2.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_forwarding_rule" "example" {
+  alias        = "` + alias + `"
+  from_user_id = data.atlassian-operations_user.test1.account_id
+  to = {
+    type = "team"
+    id   = atlassian-operations_team.example.id
+  }
+  start_date = "2030-02-01T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_forwarding_rule.example", "to.type", "team"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_forwarding_rule.example", "to.id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_forwarding_rule.example", "start_date", "2030-02-01T00:00:00Z"),
+					resource.TestCheckNoResourceAttr("atlassian-operations_forwarding_rule.example", "end_date"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
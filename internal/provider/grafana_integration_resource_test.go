@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGrafanaIntegrationResource(t *testing.T) {
+	integrationName := uuid.NewString()
+	integrationUpdateName := uuid.NewString()
+
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamConfig := `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + teamConfig + `
+resource "atlassian-operations_grafana_integration" "example" {
+  name    = "` + integrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type_specific_properties = {
+    grafana_url = "https://grafana.example.com"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_grafana_integration.example", "name", integrationName),
+					resource.TestCheckResourceAttrPair("atlassian-operations_grafana_integration.example", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_grafana_integration.example", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_grafana_integration.example", "api_key"),
+					resource.TestMatchResourceAttr("atlassian-operations_grafana_integration.example", "webhook_url", regexp.MustCompile(`/integrations/webhooks/grafana\?apiKey=.+$`)),
+					resource.TestCheckResourceAttr("atlassian-operations_grafana_integration.example", "type_specific_properties.suppress_notifications", "false"),
+					resource.TestCheckResourceAttr("atlassian-operations_grafana_integration.example", "type_specific_properties.grafana_url", "https://grafana.example.com"),
+					resource.TestCheckResourceAttr("atlassian-operations_grafana_integration.example", "type_specific_properties.close_alert_on_resolve", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "atlassian-operations_grafana_integration.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key", "webhook_url", "directions.#", "domains.#", "domains.0", "directions.0"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + teamConfig + `
+resource "atlassian-operations_grafana_integration" "example" {
+  name    = "` + integrationUpdateName + `"
+  team_id = atlassian-operations_team.example.id
+  enabled = false
+  type_specific_properties = {
+    close_alert_on_resolve = false
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_grafana_integration.example", "name", integrationUpdateName),
+					resource.TestCheckResourceAttr("atlassian-operations_grafana_integration.example", "enabled", "false"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_grafana_integration.example", "webhook_url"),
+					resource.TestCheckNoResourceAttr("atlassian-operations_grafana_integration.example", "type_specific_properties.grafana_url"),
+					resource.TestCheckResourceAttr("atlassian-operations_grafana_integration.example", "type_specific_properties.close_alert_on_resolve", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &GroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &GroupDataSource{}
+)
+
+func NewGroupDataSource() datasource.DataSource {
+	return &GroupDataSource{}
+}
+
+// GroupDataSource defines the data source implementation.
+type GroupDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *GroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *GroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Group data source",
+		Attributes:          schemaAttributes.GroupDataSourceAttributes,
+	}
+}
+
+func (d *GroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring group_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure group_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured group_data_source")
+}
+
+func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.GroupModel
+
+	tflog.Trace(ctx, "Reading group data source from Jira REST API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read group configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP request to Jira Group Picker API")
+
+	var groupPickerResponse dto.GroupPickerResponseDto
+	clientResp, err := httpClientHelpers.
+		GenerateJiraClientRequest(d.clientConfiguration).
+		Method(httpClient.GET).
+		JoinBaseUrl("/groups/picker").
+		SetQueryParams(map[string]string{
+			"query":      model.Name.ValueString(),
+			"maxResults": "1000",
+		}).
+		SetBodyParseObject(&groupPickerResponse).
+		Send()
+
+	handleHttpResponse(clientResp, err, "read group", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The group picker is a substring search, so the name is matched exactly here
+	var group *dto.GroupPickerGroupDto
+	for i := range groupPickerResponse.Groups {
+		if groupPickerResponse.Groups[i].Name == model.Name.ValueString() {
+			group = &groupPickerResponse.Groups[i]
+			break
+		}
+	}
+
+	if group == nil {
+		tflog.Error(ctx, "No groups found")
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No group named %q found", model.Name.ValueString()))
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP request to Jira Group Picker API Succeeded. Parsing the fetched data to Terraform model")
+	model = GroupDtoToModel(*group)
+
+	tflog.Trace(ctx, "Successfully read group data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &HeartbeatDataSource{}
+	_ datasource.DataSourceWithConfigure = &HeartbeatDataSource{}
+)
+
+func NewHeartbeatDataSource() datasource.DataSource {
+	return &HeartbeatDataSource{}
+}
+
+// HeartbeatDataSource defines the data source implementation.
+type HeartbeatDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *HeartbeatDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_heartbeat"
+}
+
+func (d *HeartbeatDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Heartbeat data source",
+		Attributes:          schemaAttributes.HeartbeatDataSourceAttributes,
+	}
+}
+
+func (d *HeartbeatDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring heartbeat_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure heartbeat_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured heartbeat_data_source")
+}
+
+func (d *HeartbeatDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataModels.HeartbeatDataSourceModel
+
+	tflog.Trace(ctx, "Reading heartbeat data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read heartbeat configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	teamId := data.TeamID.ValueString()
+	heartbeats := listHeartbeats(ctx, d.clientConfiguration, teamId, map[string]string{"name": data.Name.ValueString()}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var heartbeat *dto.HeartbeatDto
+	for i := range heartbeats {
+		if heartbeats[i].Name == data.Name.ValueString() {
+			heartbeat = &heartbeats[i]
+			break
+		}
+	}
+
+	if heartbeat == nil {
+		tflog.Error(ctx, "No heartbeats found")
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No heartbeat named %q found in team %s", data.Name.ValueString(), teamId))
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP requests to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+	modelPtr, diags := HeartbeatDataSourceDtoToModel(ctx, heartbeat, teamId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data = *modelPtr
+
+	tflog.Trace(ctx, "Successfully read heartbeat data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listHeartbeats returns every heartbeat of a team. Heartbeats are identified by their name, which is unique within a team.
+func listHeartbeats(ctx context.Context, configuration dto.AtlassianOpsProviderModel, teamId string, queryParams map[string]string, diagnostics *diag.Diagnostics) []dto.HeartbeatDto {
+	return listAllPages[dto.HeartbeatDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(configuration)
+		},
+		fmt.Sprintf("/v1/teams/%s/heartbeats", teamId),
+		queryParams,
+		"list heartbeats",
+		diagnostics,
+	)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHeartbeatDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	heartbeatName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_heartbeat" "example" {
+  name           = "` + heartbeatName + `"
+  description    = "Test heartbeat"
+  interval       = 5
+  interval_unit  = "minutes"
+  enabled        = true
+  team_id        = atlassian-operations_team.example.id
+  alert_message  = "Service heartbeat missed"
+  alert_tags     = ["critical", "service"]
+  alert_priority = "P2"
+}
+
+data "atlassian-operations_heartbeat" "test" {
+	depends_on = ["atlassian-operations_heartbeat.example"]
+	name    = "` + heartbeatName + `"
+	team_id = atlassian-operations_team.example.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the data source
+					// Verify all attributes are set
+					resource.TestCheckResourceAttr("data.atlassian-operations_heartbeat.test", "name", heartbeatName),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_heartbeat.test", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_heartbeat.test", "description", "Test heartbeat"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_heartbeat.test", "interval", "5"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_heartbeat.test", "interval_unit", "minutes"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_heartbeat.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_heartbeat.test", "status"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_heartbeat.test", "expired"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_heartbeat.test", "alert_message", "Service heartbeat missed"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_heartbeat.test", "alert_tags.#", "2"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_heartbeat.test", "alert_priority", "P2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &HeartbeatsDataSource{}
+	_ datasource.DataSourceWithConfigure = &HeartbeatsDataSource{}
+)
+
+func NewHeartbeatsDataSource() datasource.DataSource {
+	return &HeartbeatsDataSource{}
+}
+
+// HeartbeatsDataSource defines the data source implementation.
+type HeartbeatsDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *HeartbeatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_heartbeats"
+}
+
+func (d *HeartbeatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Heartbeats data source",
+		Attributes:          schemaAttributes.HeartbeatsDataSourceAttributes,
+	}
+}
+
+func (d *HeartbeatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring heartbeats_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure heartbeats_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured heartbeats_data_source")
+}
+
+func (d *HeartbeatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataModels.HeartbeatsDataSourceModel
+
+	tflog.Trace(ctx, "Reading heartbeats data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read heartbeats configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	teamId := data.TeamID.ValueString()
+	heartbeats := listHeartbeats(ctx, d.clientConfiguration, teamId, map[string]string{}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	heartbeatValues := make([]attr.Value, len(heartbeats))
+	for i := range heartbeats {
+		modelPtr, diags := HeartbeatDataSourceDtoToModel(ctx, &heartbeats[i], teamId)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		heartbeatValues[i] = modelPtr.AsValue()
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("HTTP requests to JSM OPS API Succeeded. Found %d heartbeats", len(heartbeatValues)))
+	data.Heartbeats = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.HeartbeatDataSourceModelMap}, heartbeatValues)
+
+	tflog.Trace(ctx, "Successfully read heartbeats data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHeartbeatsDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	heartbeatName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_heartbeat" "example" {
+  name           = "` + heartbeatName + `"
+  description    = "Test heartbeat"
+  interval       = 5
+  interval_unit  = "minutes"
+  enabled        = true
+  team_id        = atlassian-operations_team.example.id
+  alert_message  = "Service heartbeat missed"
+  alert_tags     = ["critical", "service"]
+  alert_priority = "P2"
+}
+
+resource "atlassian-operations_heartbeat" "secondary" {
+  name          = "` + heartbeatName + `-secondary"
+  interval      = 1
+  interval_unit = "hours"
+  enabled       = false
+  team_id       = atlassian-operations_team.example.id
+}
+
+data "atlassian-operations_heartbeats" "test" {
+	depends_on = ["atlassian-operations_heartbeat.example", "atlassian-operations_heartbeat.secondary"]
+	team_id = atlassian-operations_team.example.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the data source
+					resource.TestCheckResourceAttr("data.atlassian-operations_heartbeats.test", "heartbeats.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.atlassian-operations_heartbeats.test", "heartbeats.*", map[string]string{
+						"name":          heartbeatName,
+						"interval":      "5",
+						"interval_unit": "minutes",
+						"enabled":       "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.atlassian-operations_heartbeats.test", "heartbeats.*", map[string]string{
+						"name":          heartbeatName + "-secondary",
+						"interval":      "1",
+						"interval_unit": "hours",
+						"enabled":       "false",
+					}),
+				),
+			},
+		},
+	})
+}
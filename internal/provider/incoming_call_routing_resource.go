@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &IncomingCallRoutingResource{}
+	_ resource.ResourceWithConfigure   = &IncomingCallRoutingResource{}
+	_ resource.ResourceWithImportState = &IncomingCallRoutingResource{}
+)
+
+// IncomingCallRoutingResource defines the resource implementation for the routing of phone calls made to a team
+type IncomingCallRoutingResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewIncomingCallRoutingResource() resource.Resource {
+	return &IncomingCallRoutingResource{}
+}
+
+// Metadata returns metadata for the resource
+func (r *IncomingCallRoutingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incoming_call_routing"
+}
+
+// Schema defines the schema for the resource
+func (r *IncomingCallRoutingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage how the phone calls made to a number of a team are routed to its teams, escalations and schedules.",
+		Attributes:  schemaAttributes.IncomingCallRoutingResourceAttributes,
+	}
+}
+
+// Configure sets up the resource with provider configuration
+func (r *IncomingCallRoutingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring IncomingCallRoutingResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dto.JsmopsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured IncomingCallRoutingResource")
+}
+
+// Create handles the create operation for the resource
+func (r *IncomingCallRoutingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating IncomingCallRoutingResource")
+
+	var plan dataModels.IncomingCallRoutingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routingDto := IncomingCallRoutingModelToDto(ctx, plan)
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/incoming-call-routing", plan.TeamID.ValueString())).
+		Method(httpClient.POST).
+		SetBody(routingDto).
+		SetBodyParseObject(&routingDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "create incoming call routing", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := IncomingCallRoutingDtoToModel(plan.TeamID.ValueString(), routingDto)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	tflog.Trace(ctx, "Created IncomingCallRoutingResource")
+}
+
+// Read handles the read operation for the resource
+func (r *IncomingCallRoutingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataModels.IncomingCallRoutingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Reading IncomingCallRoutingResource")
+
+	var routingDto dto.IncomingCallRoutingDto
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/incoming-call-routing/%s", state.TeamID.ValueString(), state.ID.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&routingDto).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	handleHttpResponse(httpResp, err, "read incoming call routing", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := IncomingCallRoutingDtoToModel(state.TeamID.ValueString(), routingDto)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+// Update handles the update operation for the resource
+func (r *IncomingCallRoutingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataModels.IncomingCallRoutingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating IncomingCallRoutingResource")
+
+	routingDto := IncomingCallRoutingModelToDto(ctx, plan)
+
+	// The routing rules are replaced as a whole, so that removed rules and a removed fallback are not kept
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/incoming-call-routing/%s", plan.TeamID.ValueString(), plan.ID.ValueString())).
+		Method(httpClient.PUT).
+		SetBody(routingDto).
+		SetBodyParseObject(&routingDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update incoming call routing", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := IncomingCallRoutingDtoToModel(plan.TeamID.ValueString(), routingDto)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	tflog.Trace(ctx, "Updated IncomingCallRoutingResource")
+}
+
+// Delete handles the delete operation for the resource
+func (r *IncomingCallRoutingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataModels.IncomingCallRoutingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting IncomingCallRoutingResource")
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/incoming-call-routing/%s", state.TeamID.ValueString(), state.ID.ValueString())).
+		Method(httpClient.DELETE).
+		Send()
+
+	// The call routing is removed together with its team
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return
+	}
+
+	handleHttpResponse(httpResp, err, "delete incoming call routing", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted IncomingCallRoutingResource")
+}
+
+// ImportState handles importing the state of an existing resource
+func (r *IncomingCallRoutingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id,team_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[1])...)
+}
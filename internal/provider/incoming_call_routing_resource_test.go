@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIncomingCallRoutingResource(t *testing.T) {
+	routingName := uuid.NewString()
+	scheduleName := uuid.NewString()
+	escalationName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_schedule" "example" {
+  name    = "` + scheduleName + `"
+  team_id = atlassian-operations_team.example.id
+}
+
+resource "atlassian-operations_escalation" "example" {
+  name    = "` + escalationName + `"
+  team_id = atlassian-operations_team.example.id
+  description = "escalation description"
+  rules = [{
+	condition = "if-not-acked"
+	notify_type = "default"
+    delay = 5
+    recipient = {
+    	id = data.atlassian-operations_user.test1.account_id
+		type = "user"
+    }
+  }]
+  enabled = true
+}
+
+resource "atlassian-operations_incoming_call_routing" "example" {
+  team_id  = atlassian-operations_team.example.id
+  name     = "` + routingName + `"
+  greeting = "Thank you for calling the on-call team."
+
+  routing_rules = [
+    {
+      name = "Business hours"
+      time_restriction = {
+        type = "time-of-day"
+        restriction = {
+          start_hour = 9
+          end_hour = 17
+          start_min = 0
+          end_min = 0
+        }
+      }
+      recipient = {
+        type = "schedule"
+        id   = atlassian-operations_schedule.example.id
+      }
+    },
+    {
+      name = "Out of hours"
+      recipient = {
+        type = "escalation"
+        id   = atlassian-operations_escalation.example.id
+      }
+    }
+  ]
+
+  fallback = {
+    type = "voicemail"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("atlassian-operations_incoming_call_routing.example", "id"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_incoming_call_routing.example", "phone_number"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_incoming_call_routing.example", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "name", routingName),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "greeting", "Thank you for calling the on-call team."),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "routing_rules.#", "2"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "routing_rules.0.name", "Business hours"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "routing_rules.0.time_restriction.type", "time-of-day"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "routing_rules.0.time_restriction.restriction.start_hour", "9"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "routing_rules.0.time_restriction.restriction.end_hour", "17"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "routing_rules.0.recipient.type", "schedule"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_incoming_call_routing.example", "routing_rules.0.recipient.id", "atlassian-operations_schedule.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "routing_rules.1.recipient.type", "escalation"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_incoming_call_routing.example", "routing_rules.1.recipient.id", "atlassian-operations_escalation.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "fallback.type", "voicemail"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_incoming_call_routing.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_incoming_call_routing.example"].Primary.ID +
+							"," +
+							state.RootModule().Resources["atlassian-operations_incoming_call_routing.example"].Primary.Attributes["team_id"],
+						nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_schedule" "example" {
+  name    = "` + scheduleName + `"
+  team_id = atlassian-operations_team.example.id
+}
+
+resource "atlassian-operations_escalation" "example" {
+  name    = "` + escalationName + `"
+  team_id = atlassian-operations_team.example.id
+  description = "escalation description"
+  rules = [{
+	condition = "if-not-acked"
+	notify_type = "default"
+    delay = 5
+    recipient = {
+    	id = data.atlassian-operations_user.test1.account_id
+		type = "user"
+    }
+  }]
+  enabled = true
+}
+
+resource "atlassian-operations_incoming_call_routing" "example" {
+  team_id  = atlassian-operations_team.example.id
+  name     = "` + routingName + `"
+  greeting = "Please hold while we connect you to the on-call engineer."
+
+  routing_rules = [
+    {
+      name = "Always"
+      recipient = {
+        type = "team"
+        id   = atlassian-operations_team.example.id
+      }
+    }
+  ]
+
+  fallback = {
+    type = "escalation"
+    id   = atlassian-operations_escalation.example.id
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "greeting", "Please hold while we connect you to the on-call engineer."),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "routing_rules.#", "1"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "routing_rules.0.name", "Always"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "routing_rules.0.recipient.type", "team"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_incoming_call_routing.example", "routing_rules.0.recipient.id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_incoming_call_routing.example", "fallback.type", "escalation"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_incoming_call_routing.example", "fallback.id", "atlassian-operations_escalation.example", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &IntegrationDataSource{}
+	_ datasource.DataSourceWithConfigure = &IntegrationDataSource{}
+)
+
+func NewIntegrationDataSource() datasource.DataSource {
+	return &IntegrationDataSource{}
+}
+
+// IntegrationDataSource defines the data source implementation.
+type IntegrationDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *IntegrationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (d *IntegrationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Integration data source",
+		Attributes:          schemaAttributes.IntegrationDataSourceAttributes,
+	}
+}
+
+func (d *IntegrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring integration_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure integration_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured integration_data_source")
+}
+
+func (d *IntegrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.IntegrationModel
+
+	tflog.Trace(ctx, "Reading integration data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read integration configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	integrations := listIntegrations(ctx, d.clientConfiguration, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matches := make([]dto.ApiIntegration, 0)
+	for _, integration := range integrations {
+		if integration.Name != model.Name.ValueString() {
+			continue
+		}
+		if !model.Type.IsNull() && !strings.EqualFold(integration.Type, model.Type.ValueString()) {
+			continue
+		}
+		if !model.TeamId.IsNull() && integration.TeamId != model.TeamId.ValueString() {
+			continue
+		}
+		matches = append(matches, integration)
+	}
+
+	if len(matches) == 0 {
+		tflog.Error(ctx, "No integrations found")
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No integration named %q found", model.Name.ValueString()))
+		return
+	} else if len(matches) > 1 {
+		ids := make([]string, len(matches))
+		for i, integration := range matches {
+			ids[i] = integration.Id
+		}
+		tflog.Error(ctx, fmt.Sprintf("Multiple integrations found: %s", strings.Join(ids, ", ")))
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Multiple integrations named %q found (%s). Please specify type or team_id to narrow down the search.", model.Name.ValueString(), strings.Join(ids, ", ")))
+		return
+	}
+
+	integration := readIntegration(ctx, d.clientConfiguration, matches[0].Id, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP requests to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+	model = IntegrationDtoToModel(integration)
+
+	tflog.Trace(ctx, "Successfully read integration data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// listIntegrations returns every integration of the site, as summarized by the list endpoint.
+func listIntegrations(ctx context.Context, configuration dto.AtlassianOpsProviderModel, diagnostics *diag.Diagnostics) []dto.ApiIntegration {
+	return listAllPages[dto.ApiIntegration](
+		ctx,
+		func() *httpClient.Request { return httpClientHelpers.GenerateJsmOpsClientRequest(configuration) },
+		"v1/integrations",
+		map[string]string{},
+		"list integrations",
+		diagnostics,
+	)
+}
+
+// secretPropertyKeys are the parts of type specific property names that mark a secret, such as the request headers of
+// webhook integrations, which usually carry credentials
+var secretPropertyKeys = []string{"header", "apikey", "api_key", "accesskey", "access_key", "privatekey", "private_key", "token", "password", "secret", "credential"}
+
+// readIntegration returns the full definition of an integration. The API key of the integration and the secrets in its
+// type specific properties are never returned.
+func readIntegration(ctx context.Context, configuration dto.AtlassianOpsProviderModel, integrationId string, diagnostics *diag.Diagnostics) dto.ApiIntegration {
+	integration := dto.ApiIntegration{}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(configuration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", integrationId)).
+		Method(httpClient.GET).
+		SetBodyParseObject(&integration).
+		Send()
+
+	handleHttpResponse(httpResp, err, "read integration", diagnostics, ctx)
+	integration.ApiKey = ""
+	redactSecretProperties(integration.TypeSpecificProperties)
+	return integration
+}
+
+// redactSecretProperties removes the secret properties, including the ones of nested objects
+func redactSecretProperties(properties map[string]interface{}) {
+	for key, value := range properties {
+		lowerKey := strings.ToLower(key)
+		if slices.ContainsFunc(secretPropertyKeys, func(secretKey string) bool { return strings.Contains(lowerKey, secretKey) }) {
+			delete(properties, key)
+			continue
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			redactSecretProperties(nested)
+		}
+	}
+}
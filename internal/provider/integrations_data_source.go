@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &IntegrationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &IntegrationsDataSource{}
+)
+
+func NewIntegrationsDataSource() datasource.DataSource {
+	return &IntegrationsDataSource{}
+}
+
+// IntegrationsDataSource defines the data source implementation.
+type IntegrationsDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *IntegrationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integrations"
+}
+
+func (d *IntegrationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Integrations data source",
+		Attributes:          schemaAttributes.IntegrationsDataSourceAttributes,
+	}
+}
+
+func (d *IntegrationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring integrations_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure integrations_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured integrations_data_source")
+}
+
+func (d *IntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.IntegrationsDataSourceModel
+
+	tflog.Trace(ctx, "Reading integrations data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read integrations configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	integrations := listIntegrations(ctx, d.clientConfiguration, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrationValues := make([]attr.Value, 0)
+	for _, summary := range integrations {
+		if !model.Type.IsNull() && !strings.EqualFold(summary.Type, model.Type.ValueString()) {
+			continue
+		}
+		if !model.TeamId.IsNull() && summary.TeamId != model.TeamId.ValueString() {
+			continue
+		}
+		if !model.Enabled.IsNull() && summary.Enabled != model.Enabled.ValueBool() {
+			continue
+		}
+
+		// The list endpoint only returns a summary of each integration
+		integration := readIntegration(ctx, d.clientConfiguration, summary.Id, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		integrationModel := IntegrationDtoToModel(integration)
+		integrationValues = append(integrationValues, integrationModel.AsValue())
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("HTTP requests to JSM OPS API Succeeded. Found %d integrations", len(integrationValues)))
+	model.Integrations = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.IntegrationModelMap}, integrationValues)
+
+	tflog.Trace(ctx, "Successfully read integrations data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIntegrationsDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	integrationName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "` + integrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type    = "API"
+  enabled = true
+}
+
+resource "atlassian-operations_api_integration" "disabled" {
+  name    = "` + integrationName + `-disabled"
+  team_id = atlassian-operations_team.example.id
+  type    = "API"
+  enabled = false
+}
+
+data "atlassian-operations_integrations" "test" {
+	depends_on = ["atlassian-operations_api_integration.example", "atlassian-operations_api_integration.disabled"]
+	team_id = atlassian-operations_team.example.id
+}
+
+data "atlassian-operations_integrations" "test_enabled" {
+	depends_on = ["atlassian-operations_api_integration.example", "atlassian-operations_api_integration.disabled"]
+	type    = "API"
+	team_id = atlassian-operations_team.example.id
+	enabled = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the data source
+					resource.TestCheckResourceAttr("data.atlassian-operations_integrations.test", "integrations.#", "2"),
+					// Verify the filters
+					resource.TestCheckResourceAttr("data.atlassian-operations_integrations.test_enabled", "integrations.#", "1"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_integrations.test_enabled", "integrations.0.id", "atlassian-operations_api_integration.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_integrations.test_enabled", "integrations.0.name", integrationName),
+					resource.TestCheckResourceAttr("data.atlassian-operations_integrations.test_enabled", "integrations.0.type", "API"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_integrations.test_enabled", "integrations.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// maxRecurrenceLookahead bounds the search for upcoming occurrences, so that recurrences on days that rarely exist,
+// such as the 31st of the month, end instead of searching forever
+const maxRecurrenceLookahead = 5 * 366 * 24 * time.Hour
+
+// maintenanceWindow is a single occurrence of a recurring maintenance window
+type maintenanceWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// validateMaintenanceRecurrence checks the parts of a recurrence that depend on each other or can not be checked by
+// the schema validators. Values that are not known yet are not checked.
+func validateMaintenanceRecurrence(recurrence dataModels.MaintenanceRecurrenceModel, recurrencePath path.Path, diags *diag.Diagnostics) {
+	switch recurrence.Frequency.ValueString() {
+	case "weekly":
+		if recurrence.DaysOfWeek.IsNull() {
+			diags.AddAttributeError(recurrencePath.AtName("days_of_week"), "Missing Attribute Configuration",
+				"days_of_week must be set for weekly recurrences")
+		}
+		if !recurrence.DaysOfMonth.IsNull() {
+			diags.AddAttributeError(recurrencePath.AtName("days_of_month"), "Invalid Attribute Combination",
+				"days_of_month can only be set for monthly recurrences")
+		}
+	case "monthly":
+		if recurrence.DaysOfMonth.IsNull() {
+			diags.AddAttributeError(recurrencePath.AtName("days_of_month"), "Missing Attribute Configuration",
+				"days_of_month must be set for monthly recurrences")
+		}
+		if !recurrence.DaysOfWeek.IsNull() {
+			diags.AddAttributeError(recurrencePath.AtName("days_of_week"), "Invalid Attribute Combination",
+				"days_of_week can only be set for weekly recurrences")
+		}
+	}
+
+	if !recurrence.Timezone.IsNull() && !recurrence.Timezone.IsUnknown() {
+		if _, err := time.LoadLocation(recurrence.Timezone.ValueString()); err != nil {
+			diags.AddAttributeError(recurrencePath.AtName("timezone"), "Invalid Timezone",
+				fmt.Sprintf("Expected an IANA timezone identifier (e.g., Europe/London), got: %q", recurrence.Timezone.ValueString()))
+		}
+	}
+
+	parseConfiguredDate(recurrence.Until, recurrencePath.AtName("until"), diags)
+}
+
+// upcomingMaintenanceWindows returns the occurrences of the recurrence that have not ended at the given time, up to
+// the configured number of upcoming occurrences. An occurrence in progress is included, so that it is kept while it
+// is active.
+func upcomingMaintenanceWindows(ctx context.Context, recurrence dataModels.MaintenanceRecurrenceModel, now time.Time) ([]maintenanceWindow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	location, err := time.LoadLocation(recurrence.Timezone.ValueString())
+	if err != nil {
+		diags.AddError("Invalid Timezone", fmt.Sprintf("Unable to load timezone %q: %s", recurrence.Timezone.ValueString(), err))
+		return nil, diags
+	}
+
+	startTime, err := time.Parse("15:04", recurrence.StartTime.ValueString())
+	if err != nil {
+		diags.AddError("Invalid Start Time", fmt.Sprintf("Unable to parse start time %q: %s", recurrence.StartTime.ValueString(), err))
+		return nil, diags
+	}
+
+	until := time.Time{}
+	if !recurrence.Until.IsNull() {
+		until, err = time.Parse(time.RFC3339, recurrence.Until.ValueString())
+		if err != nil {
+			diags.AddError("Invalid Date", fmt.Sprintf("Unable to parse until date %q: %s", recurrence.Until.ValueString(), err))
+			return nil, diags
+		}
+	}
+
+	daysOfWeek := make([]string, 0)
+	if !recurrence.DaysOfWeek.IsNull() {
+		diags.Append(recurrence.DaysOfWeek.ElementsAs(ctx, &daysOfWeek, false)...)
+	}
+	daysOfMonth := make([]int64, 0)
+	if !recurrence.DaysOfMonth.IsNull() {
+		diags.Append(recurrence.DaysOfMonth.ElementsAs(ctx, &daysOfMonth, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	duration := time.Duration(recurrence.DurationMinutes.ValueInt64()) * time.Minute
+	count := int(recurrence.UpcomingOccurrences.ValueInt64())
+
+	// Start early enough to find an occurrence that started on a previous day and is still in progress
+	localNow := now.In(location)
+	day := time.Date(localNow.Year(), localNow.Month(), localNow.Day()-int(duration/(24*time.Hour))-1, 0, 0, 0, 0, location)
+	lastDay := now.Add(maxRecurrenceLookahead)
+
+	windows := make([]maintenanceWindow, 0, count)
+	for ; len(windows) < count && day.Before(lastDay); day = day.AddDate(0, 0, 1) {
+		switch recurrence.Frequency.ValueString() {
+		case "weekly":
+			if !slices.Contains(daysOfWeek, strings.ToLower(day.Weekday().String())) {
+				continue
+			}
+		case "monthly":
+			if !slices.Contains(daysOfMonth, int64(day.Day())) {
+				continue
+			}
+		}
+
+		start := time.Date(day.Year(), day.Month(), day.Day(), startTime.Hour(), startTime.Minute(), 0, 0, location)
+		if !until.IsZero() && start.After(until) {
+			break
+		}
+
+		end := start.Add(duration)
+		if !end.After(now) {
+			continue
+		}
+
+		windows = append(windows, maintenanceWindow{start: start, end: end})
+	}
+
+	return windows, diags
+}
+
+// findOccurrence returns the index of the occurrence that covers the window, or -1 if there is none
+func findOccurrence(occurrences []dataModels.MaintenanceOccurrenceModel, window maintenanceWindow) int {
+	return slices.IndexFunc(occurrences, func(occurrence dataModels.MaintenanceOccurrenceModel) bool {
+		start, startErr := time.Parse(time.RFC3339, occurrence.StartDate.ValueString())
+		end, endErr := time.Parse(time.RFC3339, occurrence.EndDate.ValueString())
+		return startErr == nil && endErr == nil && start.Equal(window.start) && end.Equal(window.end)
+	})
+}
+
+// occurrencesMatchWindows reports whether the occurrences are exactly the given windows, in the same order
+func occurrencesMatchWindows(occurrences []dataModels.MaintenanceOccurrenceModel, windows []maintenanceWindow) bool {
+	if len(occurrences) != len(windows) {
+		return false
+	}
+
+	for i, window := range windows {
+		if findOccurrence(occurrences[i:i+1], window) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
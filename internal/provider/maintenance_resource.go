@@ -3,23 +3,31 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &MaintenanceResource{}
-	_ resource.ResourceWithConfigure   = &MaintenanceResource{}
-	_ resource.ResourceWithImportState = &MaintenanceResource{}
+	_ resource.Resource                   = &MaintenanceResource{}
+	_ resource.ResourceWithConfigure      = &MaintenanceResource{}
+	_ resource.ResourceWithImportState    = &MaintenanceResource{}
+	_ resource.ResourceWithValidateConfig = &MaintenanceResource{}
+	_ resource.ResourceWithModifyPlan     = &MaintenanceResource{}
 )
 
 // MaintenanceResource defines the resource implementation for maintenances
@@ -65,6 +73,102 @@ func (r *MaintenanceResource) Configure(ctx context.Context, req resource.Config
 	tflog.Trace(ctx, "Configured MaintenanceResource")
 }
 
+// ValidateConfig checks that the maintenance window either ends after it starts or has a valid recurrence
+func (r *MaintenanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dataModels.MaintenanceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dates := map[string]types.String{"start_date": config.StartDate, "end_date": config.EndDate}
+
+	if config.Recurrence.IsNull() {
+		for name, value := range dates {
+			if value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(name), "Missing Attribute Configuration",
+					fmt.Sprintf("%s must be set unless recurrence is set", name))
+			}
+		}
+
+		validateDateRange(config.StartDate, config.EndDate, path.Root("start_date"), path.Root("end_date"), &resp.Diagnostics)
+		return
+	}
+
+	for name, value := range dates {
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Combination",
+				fmt.Sprintf("%s can not be set together with recurrence, the dates of the occurrences are generated from the recurrence", name))
+		}
+	}
+
+	if config.Recurrence.IsUnknown() {
+		return
+	}
+
+	var recurrence dataModels.MaintenanceRecurrenceModel
+	resp.Diagnostics.Append(config.Recurrence.As(ctx, &recurrence, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateMaintenanceRecurrence(recurrence, path.Root("recurrence"), &resp.Diagnostics)
+}
+
+// ModifyPlan plans the roll forward of the occurrences of a recurring maintenance window once an occurrence has ended,
+// even if the configuration did not change
+func (r *MaintenanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan dataModels.MaintenanceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only recurring maintenance windows have occurrences
+	if plan.Recurrence.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("occurrences"), types.ListNull(types.ObjectType{AttrTypes: dataModels.MaintenanceOccurrenceModelMap}))...)
+		return
+	}
+
+	if req.State.Raw.IsNull() || plan.Recurrence.IsUnknown() {
+		return
+	}
+	for _, value := range plan.Recurrence.Attributes() {
+		if value.IsUnknown() {
+			return
+		}
+	}
+
+	var state dataModels.MaintenanceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.Recurrence.IsNull() {
+		return
+	}
+
+	var recurrence dataModels.MaintenanceRecurrenceModel
+	resp.Diagnostics.Append(plan.Recurrence.As(ctx, &recurrence, basetypes.ObjectAsOptions{})...)
+	occurrences := make([]dataModels.MaintenanceOccurrenceModel, 0)
+	resp.Diagnostics.Append(state.Occurrences.ElementsAs(ctx, &occurrences, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Invalid recurrences are reported by ValidateConfig
+	windows, diags := upcomingMaintenanceWindows(ctx, recurrence, time.Now())
+	if diags.HasError() {
+		return
+	}
+
+	if !occurrencesMatchWindows(occurrences, windows) {
+		tflog.Debug(ctx, "Occurrences of the recurring maintenance window are rolled forward", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("occurrences"), types.ListUnknown(types.ObjectType{AttrTypes: dataModels.MaintenanceOccurrenceModelMap}))...)
+	}
+}
+
 // Create handles the create operation for the resource
 func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "Creating MaintenanceResource")
@@ -75,6 +179,14 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if !plan.Recurrence.IsNull() {
+		// The occurrences are tracked under an ID of their own, since each of them is a separate maintenance window
+		plan.ID = types.StringValue(uuid.NewString())
+		r.applyRecurrence(ctx, &plan, nil, true, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	// Convert to DTO
 	maintenanceDto, diags := MaintenanceModelToDto(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -83,12 +195,7 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Create maintenance window
-	var endpoint string
-	if plan.TeamID.ValueString() != "" {
-		endpoint = fmt.Sprintf("/v1/teams/%s/maintenances", plan.TeamID.ValueString())
-	} else {
-		endpoint = "/v1/maintenances"
-	}
+	endpoint := maintenancesUrl(plan.TeamID.ValueString())
 
 	httpResp, err := httpClientHelpers.
 		GenerateJsmOpsClientRequest(r.clientConfiguration).
@@ -126,6 +233,11 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 	// Update state with response
 	result, diags := MaintenanceDtoToModel(ctx, maintenanceDto)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result.StartDate = keepConfiguredDate(plan.StartDate, maintenanceDto.StartDate)
+	result.EndDate = keepConfiguredDate(plan.EndDate, maintenanceDto.EndDate)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
@@ -139,14 +251,17 @@ func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest
 
 	tflog.Trace(ctx, "Reading MaintenanceResource")
 
-	// Determine endpoint based on whether we have a team ID
-	var endpoint string
-	if state.TeamID.ValueString() != "" {
-		endpoint = fmt.Sprintf("/v1/teams/%s/maintenances/%s", state.TeamID.ValueString(), state.ID.ValueString())
-	} else {
-		endpoint = fmt.Sprintf("/v1/maintenances/%s", state.ID.ValueString())
+	if !state.Recurrence.IsNull() {
+		r.readRecurrence(ctx, &state, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
 	}
 
+	endpoint := maintenanceUrl(state.TeamID.ValueString(), state.ID.ValueString())
+
 	var maintenanceDto dto.MaintenanceDto
 	httpResp, err := httpClientHelpers.
 		GenerateJsmOpsClientRequest(r.clientConfiguration).
@@ -191,6 +306,11 @@ func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest
 
 	result, diags := MaintenanceDtoToModel(ctx, &maintenanceDto)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result.StartDate = keepConfiguredDate(state.StartDate, maintenanceDto.StartDate)
+	result.EndDate = keepConfiguredDate(state.EndDate, maintenanceDto.EndDate)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
@@ -202,6 +322,21 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	if !plan.Recurrence.IsNull() {
+		var state dataModels.MaintenanceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		occurrences := make([]dataModels.MaintenanceOccurrenceModel, 0)
+		resp.Diagnostics.Append(state.Occurrences.ElementsAs(ctx, &occurrences, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		contentChanged := !plan.Description.Equal(state.Description) || !plan.Rules.Equal(state.Rules)
+		r.applyRecurrence(ctx, &plan, occurrences, contentChanged, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	// Convert to DTO
 	maintenanceDto, diags := MaintenanceModelToDto(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -209,13 +344,7 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	// Determine endpoint based on whether we have a team ID
-	var endpoint string
-	if plan.TeamID.ValueString() != "" {
-		endpoint = fmt.Sprintf("/v1/teams/%s/maintenances/%s", plan.TeamID.ValueString(), plan.ID.ValueString())
-	} else {
-		endpoint = fmt.Sprintf("/v1/maintenances/%s", plan.ID.ValueString())
-	}
+	endpoint := maintenanceUrl(plan.TeamID.ValueString(), plan.ID.ValueString())
 
 	// Update maintenance window
 	httpResp, err := httpClientHelpers.
@@ -253,6 +382,11 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 
 	result, diags := MaintenanceDtoToModel(ctx, maintenanceDto)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result.StartDate = keepConfiguredDate(plan.StartDate, maintenanceDto.StartDate)
+	result.EndDate = keepConfiguredDate(plan.EndDate, maintenanceDto.EndDate)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
@@ -264,14 +398,20 @@ func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	// Determine endpoint based on whether we have a team ID
-	var endpoint string
-	if state.TeamID.ValueString() != "" {
-		endpoint = fmt.Sprintf("/v1/teams/%s/maintenances/%s", state.TeamID.ValueString(), state.ID.ValueString())
-	} else {
-		endpoint = fmt.Sprintf("/v1/maintenances/%s", state.ID.ValueString())
+	if !state.Recurrence.IsNull() {
+		occurrences := make([]dataModels.MaintenanceOccurrenceModel, 0)
+		resp.Diagnostics.Append(state.Occurrences.ElementsAs(ctx, &occurrences, false)...)
+		for _, occurrence := range occurrences {
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			r.deleteOccurrence(ctx, state.TeamID.ValueString(), occurrence.ID.ValueString(), &resp.Diagnostics)
+		}
+		return
 	}
 
+	endpoint := maintenanceUrl(state.TeamID.ValueString(), state.ID.ValueString())
+
 	httpResp, err := httpClientHelpers.
 		GenerateJsmOpsClientRequest(r.clientConfiguration).
 		JoinBaseUrl(endpoint).
@@ -321,3 +461,181 @@ func (r *MaintenanceResource) ImportState(ctx context.Context, req resource.Impo
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[1])...)
 	}
 }
+
+// applyRecurrence keeps the upcoming occurrences of a recurring maintenance window created. Occurrences that are still
+// upcoming are kept, and updated if updateContent is set, missing occurrences are created and occurrences that no
+// longer match the recurrence are deleted. Occurrences that have ended are left as they are.
+func (r *MaintenanceResource) applyRecurrence(ctx context.Context, plan *dataModels.MaintenanceModel, occurrences []dataModels.MaintenanceOccurrenceModel, updateContent bool, diags *diag.Diagnostics) {
+	teamId := plan.TeamID.ValueString()
+	kept := make([]dataModels.MaintenanceOccurrenceModel, 0, len(occurrences))
+
+	// Occurrences created before a failure are kept in the state, so that they are not left behind
+	defer func() {
+		occurrencesList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dataModels.MaintenanceOccurrenceModelMap}, kept)
+		diags.Append(listDiags...)
+		plan.Occurrences = occurrencesList
+		plan.Status = types.StringNull()
+	}()
+
+	var recurrence dataModels.MaintenanceRecurrenceModel
+	diags.Append(plan.Recurrence.As(ctx, &recurrence, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return
+	}
+
+	windows, windowDiags := upcomingMaintenanceWindows(ctx, recurrence, time.Now())
+	diags.Append(windowDiags...)
+	maintenanceDto, dtoDiags := MaintenanceModelToDto(ctx, plan)
+	diags.Append(dtoDiags...)
+	if diags.HasError() {
+		return
+	}
+	maintenanceDto.ID = ""
+
+	remaining := slices.Clone(occurrences)
+	for _, window := range windows {
+		maintenanceDto.StartDate = window.start.UTC().Format(time.RFC3339)
+		maintenanceDto.EndDate = window.end.UTC().Format(time.RFC3339)
+
+		if i := findOccurrence(remaining, window); i != -1 {
+			occurrence := remaining[i]
+			remaining = slices.Delete(remaining, i, i+1)
+
+			if updateContent {
+				httpResp, err := httpClientHelpers.
+					GenerateJsmOpsClientRequest(r.clientConfiguration).
+					JoinBaseUrl(maintenanceUrl(teamId, occurrence.ID.ValueString())).
+					Method(httpClient.PATCH).
+					SetBody(maintenanceDto).
+					Send()
+
+				handleHttpResponse(httpResp, err, "update maintenance window occurrence", diags, ctx)
+				if diags.HasError() {
+					kept = append(kept, occurrence)
+					kept = append(kept, remaining...)
+					return
+				}
+			}
+
+			kept = append(kept, occurrence)
+			continue
+		}
+
+		created := dto.MaintenanceDto{}
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(r.clientConfiguration).
+			JoinBaseUrl(maintenancesUrl(teamId)).
+			Method(httpClient.POST).
+			SetBody(maintenanceDto).
+			SetBodyParseObject(&created).
+			Send()
+
+		handleHttpResponse(httpResp, err, "create maintenance window occurrence", diags, ctx)
+		if diags.HasError() {
+			kept = append(kept, remaining...)
+			return
+		}
+
+		kept = append(kept, dataModels.MaintenanceOccurrenceModel{
+			ID:        types.StringValue(created.ID),
+			StartDate: types.StringValue(maintenanceDto.StartDate),
+			EndDate:   types.StringValue(maintenanceDto.EndDate),
+		})
+	}
+
+	for i, occurrence := range remaining {
+		if hasDatePassed(occurrence.EndDate.ValueString()) {
+			continue
+		}
+
+		r.deleteOccurrence(ctx, teamId, occurrence.ID.ValueString(), diags)
+		if diags.HasError() {
+			kept = append(kept, remaining[i:]...)
+			return
+		}
+	}
+}
+
+// readRecurrence drops the occurrences of a recurring maintenance window that have ended or were deleted, and takes
+// the description and rules of the next occurrence, so that changes made to it show up as a difference
+func (r *MaintenanceResource) readRecurrence(ctx context.Context, state *dataModels.MaintenanceModel, diags *diag.Diagnostics) {
+	occurrences := make([]dataModels.MaintenanceOccurrenceModel, 0)
+	diags.Append(state.Occurrences.ElementsAs(ctx, &occurrences, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	remaining := make([]dataModels.MaintenanceOccurrenceModel, 0, len(occurrences))
+	var next *dto.MaintenanceDto
+	for _, occurrence := range occurrences {
+		if hasDatePassed(occurrence.EndDate.ValueString()) {
+			continue
+		}
+
+		maintenanceDto := dto.MaintenanceDto{}
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(r.clientConfiguration).
+			JoinBaseUrl(maintenanceUrl(state.TeamID.ValueString(), occurrence.ID.ValueString())).
+			Method(httpClient.GET).
+			SetBodyParseObject(&maintenanceDto).
+			Send()
+
+		if httpResp != nil && httpResp.GetStatusCode() == 404 {
+			continue
+		}
+
+		handleHttpResponse(httpResp, err, "read maintenance window occurrence", diags, ctx)
+		if diags.HasError() {
+			return
+		}
+
+		remaining = append(remaining, occurrence)
+		if next == nil {
+			next = &maintenanceDto
+		}
+	}
+
+	if next != nil {
+		result, resultDiags := MaintenanceDtoToModel(ctx, next)
+		diags.Append(resultDiags...)
+		if diags.HasError() {
+			return
+		}
+
+		if !state.Description.IsNull() || next.Description != "" {
+			state.Description = result.Description
+		}
+		state.Rules = result.Rules
+	}
+
+	occurrencesList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dataModels.MaintenanceOccurrenceModelMap}, remaining)
+	diags.Append(listDiags...)
+	state.Occurrences = occurrencesList
+}
+
+func (r *MaintenanceResource) deleteOccurrence(ctx context.Context, teamId string, id string, diags *diag.Diagnostics) {
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(maintenanceUrl(teamId, id)).
+		Method(httpClient.DELETE).
+		Send()
+
+	// The occurrence may have been deleted in the meantime
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return
+	}
+
+	handleHttpResponse(httpResp, err, "delete maintenance window occurrence", diags, ctx)
+}
+
+// maintenancesUrl returns the URL of the maintenance windows of the team, or of the global ones if teamId is empty
+func maintenancesUrl(teamId string) string {
+	if teamId == "" {
+		return "/v1/maintenances"
+	}
+	return fmt.Sprintf("/v1/teams/%s/maintenances", teamId)
+}
+
+func maintenanceUrl(teamId string, id string) string {
+	return fmt.Sprintf("%s/%s", maintenancesUrl(teamId), id)
+}
@@ -114,6 +114,55 @@ func TestAccMaintenanceResourceWithTeam(t *testing.T) {
 	})
 }
 
+func TestAccRecurringMaintenanceResource(t *testing.T) {
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamName := uuid.NewString()
+	apiIntegrationName := uuid.NewString()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing for recurring maintenance window
+			{
+				Config: providerConfig + testAccRecurringMaintenanceResourceConfig(emailPrimary, teamName, organizationId, apiIntegrationName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("atlassian-operations_maintenance.recurring_test", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.recurring_test", "description", "Weekly Maintenance Window"),
+					resource.TestCheckNoResourceAttr("atlassian-operations_maintenance.recurring_test", "start_date"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.recurring_test", "recurrence.frequency", "weekly"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.recurring_test", "recurrence.days_of_week.#", "1"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.recurring_test", "recurrence.upcoming_occurrences", "3"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.recurring_test", "occurrences.#", "3"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_maintenance.recurring_test", "occurrences.0.id"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_maintenance.recurring_test", "occurrences.0.start_date"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_maintenance.recurring_test", "occurrences.0.end_date"),
+				),
+			},
+			// Update and Read testing for recurring maintenance window
+			{
+				Config: providerConfig + testAccRecurringMaintenanceResourceUpdatedConfig(emailPrimary, teamName, organizationId, apiIntegrationName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.recurring_test", "description", "Monthly Maintenance Window"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.recurring_test", "recurrence.frequency", "monthly"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.recurring_test", "recurrence.days_of_month.#", "2"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.recurring_test", "recurrence.upcoming_occurrences", "4"),
+					resource.TestCheckResourceAttr("atlassian-operations_maintenance.recurring_test", "occurrences.#", "4"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccMaintenanceResourceConfig(apiPrimary string, teamName string, organizationId string, apiIntegrationName string) string {
 	return `
 data "atlassian-operations_user" "test1" {
@@ -288,3 +337,104 @@ resource "atlassian-operations_maintenance" "team_test" {
 }
 `
 }
+
+func testAccRecurringMaintenanceResourceConfig(apiPrimary string, teamName string, organizationId string, apiIntegrationName string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + apiPrimary + `"
+  	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "` + apiIntegrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type = "API"
+  enabled = true
+}
+
+resource "atlassian-operations_maintenance" "recurring_test" {
+  description = "Weekly Maintenance Window"
+  team_id     = atlassian-operations_team.example.id
+
+  recurrence = {
+    frequency            = "weekly"
+    days_of_week         = ["sunday"]
+    start_time           = "02:00"
+    duration_minutes     = 120
+    timezone             = "Europe/London"
+    upcoming_occurrences = 3
+  }
+
+  rules = [ {
+    state = "disabled"
+    entity = {
+      id   = atlassian-operations_api_integration.example.id
+      type = "integration"
+    }
+  }
+  ]
+}
+`
+}
+
+func testAccRecurringMaintenanceResourceUpdatedConfig(apiPrimary string, teamName string, organizationId string, apiIntegrationName string) string {
+	return `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + apiPrimary + `"
+  	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "` + apiIntegrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type = "API"
+  enabled = true
+}
+
+resource "atlassian-operations_maintenance" "recurring_test" {
+  description = "Monthly Maintenance Window"
+  team_id     = atlassian-operations_team.example.id
+
+  recurrence = {
+    frequency        = "monthly"
+    days_of_month    = [1, 15]
+    start_time       = "22:30"
+    duration_minutes = 240
+    timezone         = "America/New_York"
+  }
+
+  rules = [ {
+    state = "disabled"
+    entity = {
+      id   = atlassian-operations_api_integration.example.id
+      type = "integration"
+    }
+  }
+  ]
+}
+`
+}
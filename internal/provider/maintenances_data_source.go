@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &MaintenancesDataSource{}
+	_ datasource.DataSourceWithConfigure = &MaintenancesDataSource{}
+)
+
+func NewMaintenancesDataSource() datasource.DataSource {
+	return &MaintenancesDataSource{}
+}
+
+// MaintenancesDataSource defines the data source implementation.
+type MaintenancesDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *MaintenancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenances"
+}
+
+func (d *MaintenancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Maintenances data source",
+		Attributes:          schemaAttributes.MaintenancesDataSourceAttributes,
+	}
+}
+
+func (d *MaintenancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring maintenances_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure maintenances_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured maintenances_data_source")
+}
+
+func (d *MaintenancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataModels.MaintenancesDataSourceModel
+
+	tflog.Trace(ctx, "Reading maintenances data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read maintenances configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	// Team maintenances are only reachable through the team endpoints
+	endpoint := "/v1/maintenances"
+	if data.TeamID.ValueString() != "" {
+		endpoint = fmt.Sprintf("/v1/teams/%s/maintenances", data.TeamID.ValueString())
+	}
+
+	maintenanceType := "all"
+	if !data.Type.IsNull() {
+		maintenanceType = data.Type.ValueString()
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	maintenances := listAllPages[dto.MaintenanceDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(d.clientConfiguration)
+		},
+		endpoint,
+		map[string]string{"type": maintenanceType},
+		"list maintenances",
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceValues := make([]attr.Value, 0)
+	for _, summary := range maintenances {
+		// The list endpoint does not return the rules of the maintenances
+		var maintenanceDto dto.MaintenanceDto
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(d.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/%s", endpoint, summary.ID)).
+			Method(httpClient.GET).
+			SetBodyParseObject(&maintenanceDto).
+			Send()
+
+		handleHttpResponse(httpResp, err, "read maintenance", &resp.Diagnostics, ctx)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !data.EntityID.IsNull() && !maintenanceAffectsEntity(maintenanceDto, data.EntityID.ValueString()) {
+			continue
+		}
+		if maintenanceDto.TeamID == "" {
+			maintenanceDto.TeamID = data.TeamID.ValueString()
+		}
+
+		modelPtr, diags := MaintenanceDtoToModel(ctx, &maintenanceDto)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		maintenanceValues = append(maintenanceValues, modelPtr.AsValue())
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("HTTP requests to JSM OPS API Succeeded. Found %d maintenances", len(maintenanceValues)))
+	data.Maintenances = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.MaintenanceModelMap}, maintenanceValues)
+
+	tflog.Trace(ctx, "Successfully read maintenances data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func maintenanceAffectsEntity(maintenance dto.MaintenanceDto, entityId string) bool {
+	for _, rule := range maintenance.Rules {
+		if rule.Entity.ID == entityId {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMaintenancesDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	integrationName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_api_integration" "example" {
+  name    = "` + integrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type    = "API"
+  enabled = true
+}
+
+resource "atlassian-operations_maintenance" "example" {
+  description = "Team Maintenance Window"
+  start_date  = "2029-06-15T10:00:00Z"
+  end_date    = "2029-06-15T14:00:00Z"
+  team_id     = atlassian-operations_team.example.id
+
+  rules = [
+    {
+      state = "disabled"
+      entity = {
+        id   = atlassian-operations_api_integration.example.id
+        type = "integration"
+      }
+    }
+  ]
+}
+
+data "atlassian-operations_maintenances" "test" {
+	depends_on = ["atlassian-operations_maintenance.example"]
+	type      = "non-expired"
+	team_id   = atlassian-operations_team.example.id
+	entity_id = atlassian-operations_api_integration.example.id
+}
+
+data "atlassian-operations_maintenances" "test_past" {
+	depends_on = ["atlassian-operations_maintenance.example"]
+	type    = "past"
+	team_id = atlassian-operations_team.example.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the data source
+					resource.TestCheckResourceAttr("data.atlassian-operations_maintenances.test", "maintenances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_maintenances.test", "maintenances.0.id", "atlassian-operations_maintenance.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_maintenances.test", "maintenances.0.description", "Team Maintenance Window"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_maintenances.test", "maintenances.0.start_date", "2029-06-15T10:00:00Z"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_maintenances.test", "maintenances.0.end_date", "2029-06-15T14:00:00Z"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_maintenances.test", "maintenances.0.team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_maintenances.test", "maintenances.0.rules.#", "1"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_maintenances.test", "maintenances.0.rules.0.state", "disabled"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_maintenances.test", "maintenances.0.rules.0.entity.id", "atlassian-operations_api_integration.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_maintenances.test", "maintenances.0.rules.0.entity.type", "integration"),
+					// Verify the type filter
+					resource.TestCheckResourceAttr("data.atlassian-operations_maintenances.test_past", "maintenances.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MonitoringIntegrationResource{}
+var _ resource.ResourceWithImportState = &MonitoringIntegrationResource{}
+
+func NewPrometheusIntegrationResource() resource.Resource {
+	return &MonitoringIntegrationResource{
+		typeName:        "_prometheus_integration",
+		integrationType: "Prometheus",
+		webhookEndpoint: "prometheus",
+		attributes:      schemaAttributes.PrometheusIntegrationResourceAttributes,
+		propertyKeys: map[string]string{
+			"suppress_notifications": "suppressNotifications",
+			"close_alert_on_resolve": "closeAlertOnResolve",
+		},
+	}
+}
+
+func NewDatadogIntegrationResource() resource.Resource {
+	return &MonitoringIntegrationResource{
+		typeName:        "_datadog_integration",
+		integrationType: "Datadog",
+		webhookEndpoint: "datadog",
+		attributes:      schemaAttributes.DatadogIntegrationResourceAttributes,
+		propertyKeys: map[string]string{
+			"suppress_notifications":  "suppressNotifications",
+			"datadog_site":            "datadogSite",
+			"close_alert_on_recovery": "closeAlertOnRecovery",
+		},
+	}
+}
+
+func NewCloudWatchIntegrationResource() resource.Resource {
+	return &MonitoringIntegrationResource{
+		typeName:        "_cloudwatch_integration",
+		integrationType: "CloudWatch",
+		webhookEndpoint: "cloudwatch",
+		attributes:      schemaAttributes.CloudWatchIntegrationResourceAttributes,
+		propertyKeys: map[string]string{
+			"suppress_notifications": "suppressNotifications",
+			"aws_region":             "region",
+			"close_alert_on_ok":      "closeAlertOnOk",
+		},
+	}
+}
+
+func NewGrafanaIntegrationResource() resource.Resource {
+	return &MonitoringIntegrationResource{
+		typeName:        "_grafana_integration",
+		integrationType: "Grafana",
+		webhookEndpoint: "grafana",
+		attributes:      schemaAttributes.GrafanaIntegrationResourceAttributes,
+		propertyKeys: map[string]string{
+			"suppress_notifications": "suppressNotifications",
+			"grafana_url":            "grafanaUrl",
+			"close_alert_on_resolve": "closeAlertOnResolve",
+		},
+	}
+}
+
+// MonitoringIntegrationResource defines the resource implementation shared by the inbound monitoring tool
+// integrations. They are stored as integrations of the given type, with the tool specific settings in their type
+// specific properties, and receive alerts on their webhook URL.
+type MonitoringIntegrationResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+	typeName            string
+	integrationType     string
+	webhookEndpoint     string
+	attributes          map[string]schema.Attribute
+	propertyKeys        map[string]string
+}
+
+func (r *MonitoringIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *MonitoringIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: r.attributes,
+	}
+}
+
+func (r *MonitoringIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, fmt.Sprintf("Configuring MonitoringIntegrationResource for %s", r.integrationType))
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Unexpected Resource Configure Type")
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+
+	tflog.Trace(ctx, fmt.Sprintf("Configured MonitoringIntegrationResource for %s", r.integrationType))
+}
+
+func (r *MonitoringIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, fmt.Sprintf("Creating the %s integration", r.integrationType))
+
+	var data dataModels.MonitoringIntegrationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dtoObj := MonitoringIntegrationModelToDto(ctx, data, r.integrationType, r.propertyKeys)
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl("v1/integrations").
+		Method(httpClient.POST).
+		SetBody(dtoObj).
+		SetBodyParseObject(&dtoObj).
+		Send()
+
+	handleHttpResponse(httpResp, err, fmt.Sprintf("create %s integration", r.integrationType), &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = r.dtoToModel(dtoObj, data)
+
+	tflog.Trace(ctx, fmt.Sprintf("Created the %s integration", r.integrationType))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitoringIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataModels.MonitoringIntegrationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Reading the %s integration", r.integrationType))
+
+	dtoObj := dto.ApiIntegration{}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&dtoObj).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	handleHttpResponse(httpResp, err, fmt.Sprintf("read %s integration", r.integrationType), &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if dtoObj.Type != r.integrationType {
+		resp.Diagnostics.AddError(
+			"Unexpected Integration Type",
+			fmt.Sprintf("Integration %s is of type '%s', expected '%s'", data.Id.ValueString(), dtoObj.Type, r.integrationType),
+		)
+		return
+	}
+
+	data = r.dtoToModel(dtoObj, data)
+
+	tflog.Trace(ctx, fmt.Sprintf("Read the %s integration", r.integrationType))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitoringIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data dataModels.MonitoringIntegrationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Updating the %s integration", r.integrationType))
+
+	dtoObj := MonitoringIntegrationModelToDto(ctx, data, r.integrationType, r.propertyKeys)
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.PATCH).
+		SetBody(dtoObj).
+		SetBodyParseObject(&dtoObj).
+		Send()
+
+	handleHttpResponse(httpResp, err, fmt.Sprintf("update %s integration", r.integrationType), &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = r.dtoToModel(dtoObj, data)
+
+	tflog.Trace(ctx, fmt.Sprintf("Updated the %s integration", r.integrationType))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitoringIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dataModels.MonitoringIntegrationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Deleting the %s integration", r.integrationType))
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.DELETE).
+		Send()
+
+	handleHttpResponse(httpResp, err, fmt.Sprintf("delete %s integration", r.integrationType), &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Deleted the %s integration", r.integrationType))
+}
+
+func (r *MonitoringIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// dtoToModel converts the integration to the typed model of this tool, and derives the webhook URL from the API key,
+// which is only returned when the integration is created.
+func (r *MonitoringIntegrationResource) dtoToModel(dtoObj dto.ApiIntegration, oldModel dataModels.MonitoringIntegrationModel) dataModels.MonitoringIntegrationModel {
+	propertyTypes := r.attributes["type_specific_properties"].GetType().(types.ObjectType).AttrTypes
+	model := MonitoringIntegrationDtoToModel(dtoObj, oldModel, propertyTypes, r.propertyKeys)
+
+	if !(model.ApiKey.IsNull() || model.ApiKey.IsUnknown()) {
+		model.WebhookUrl = types.StringValue(
+			httpClientHelpers.GetIntegrationWebhookUrl(r.clientConfiguration, r.webhookEndpoint, model.ApiKey.ValueString()),
+		)
+	}
+
+	return model
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &NotificationPoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &NotificationPoliciesDataSource{}
+)
+
+func NewNotificationPoliciesDataSource() datasource.DataSource {
+	return &NotificationPoliciesDataSource{}
+}
+
+// NotificationPoliciesDataSource defines the data source implementation.
+type NotificationPoliciesDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *NotificationPoliciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_policies"
+}
+
+func (d *NotificationPoliciesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Notification policies data source",
+		Attributes:          schemaAttributes.NotificationPoliciesDataSourceAttributes,
+	}
+}
+
+func (d *NotificationPoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring notification_policies_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure notification_policies_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured notification_policies_data_source")
+}
+
+func (d *NotificationPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.NotificationPoliciesDataSourceModel
+	var nameRegex *regexp.Regexp
+
+	tflog.Trace(ctx, "Reading notification policies data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read notification policies configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	if !model.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(model.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Unable to compile name_regex, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	endpoint := fmt.Sprintf("/v1/teams/%s/policies", model.TeamID.ValueString())
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	policies := listAllPages[dto.BaseNotificationPolicyDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(d.clientConfiguration)
+		},
+		endpoint,
+		map[string]string{"type": "notification"},
+		"list notification policies",
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP requests to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+
+	policyValues := make([]attr.Value, 0)
+	for _, policy := range policies {
+		if nameRegex != nil && !nameRegex.MatchString(policy.Name) {
+			continue
+		}
+		policyModel := NotificationPolicySummaryDtoToModel(model.TeamID.ValueString(), policy)
+		policyValues = append(policyValues, policyModel.AsValue())
+	}
+	model.NotificationPolicies = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.NotificationPolicySummaryModelMap}, policyValues)
+
+	tflog.Trace(ctx, "Successfully read notification policies data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationPolicyOrderResource(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_notification_policy" "first" {
+  name    = "First Notification "atlassian-operations_notification_policy.olicy"
+  type    = "notification"
+  team_id = atlassian-operations_team.example.id
+  enabled = true
+}
+
+resource "atlassian-operations_notification_policy" "second" {
+  name    = "Second Notification "atlassian-operations_notification_policy.olicy"
+  type    = "notification"
+  team_id = atlassian-operations_team.example.id
+  enabled = true
+}
+
+resource "atlassian-operations_notification_policy_order" "example" {
+  team_id = atlassian-operations_team.example.id
+  policy_ids = [
+    atlassian-operations_notification_policy.second.id,
+    atlassian-operations_notification_policy.first.id,
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_notification_policy_order.example", "id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_notification_policy_order.example", "policy_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_notification_policy_order.example", "policy_ids.0", "atlassian-operations_notification_policy.second", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_notification_policy_order.example", "policy_ids.1", "atlassian-operations_notification_policy.first", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_notification_policy_order.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_notification_policy_order.example"].Primary.Attributes["team_id"], nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_notification_policy" "first" {
+  name    = "First Notification "atlassian-operations_notification_policy.olicy"
+  type    = "notification"
+  team_id = atlassian-operations_team.example.id
+  enabled = true
+}
+
+resource "atlassian-operations_notification_policy" "second" {
+  name    = "Second Notification "atlassian-operations_notification_policy.olicy"
+  type    = "notification"
+  team_id = atlassian-operations_team.example.id
+  enabled = true
+}
+
+resource "atlassian-operations_notification_policy_order" "example" {
+  team_id = atlassian-operations_team.example.id
+  policy_ids = [
+    atlassian-operations_notification_policy.first.id,
+    atlassian-operations_notification_policy.second.id,
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_notification_policy_order.example", "policy_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_notification_policy_order.example", "policy_ids.0", "atlassian-operations_notification_policy.first", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_notification_policy_order.example", "policy_ids.1", "atlassian-operations_notification_policy.second", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -213,8 +213,9 @@ func (r *NotificationPolicyResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	order := getNotificationPolicyOrder(ctx, r.clientConfiguration, data.TeamID.ValueString(), notificationPolicyDto.ID)
-	result, _ := NotificationPolicyDtoToModel(ctx, order, notificationPolicyDto)
+	// The planned order is kept, since deleting another policy in the same apply can shift this policy, and the next
+	// read picks up the new order
+	result, _ := NotificationPolicyDtoToModel(ctx, data.Order.ValueFloat64(), notificationPolicyDto)
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
@@ -0,0 +1,25 @@
+package provider
+
+import "slices"
+
+// moveToFront reorders currentOrder one ID at a time, so that the IDs of desiredOrder end up at its start in the
+// given order, while the IDs that are not listed keep their relative order after them. Every ID of desiredOrder
+// must be present in currentOrder. move is called with the target index of every ID that is not already in place,
+// and the reordering stops as soon as it returns false.
+func moveToFront(currentOrder []string, desiredOrder []string, move func(id string, targetIndex int) bool) bool {
+	order := slices.Clone(currentOrder)
+
+	for targetIndex, id := range desiredOrder {
+		currentIndex := slices.Index(order, id)
+		if currentIndex == targetIndex {
+			continue
+		}
+		if !move(id, targetIndex) {
+			return false
+		}
+		order = slices.Delete(order, currentIndex, currentIndex+1)
+		order = slices.Insert(order, targetIndex, id)
+	}
+
+	return true
+}
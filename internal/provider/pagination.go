@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// listAllPages fetches every page of a paginated list endpoint by following the "links.next" URL of each response,
+// and returns the values of all pages. Errors are reported to diagnostics using the given operation description.
+func listAllPages[T any](
+	ctx context.Context,
+	newRequest func() *httpClient.Request,
+	baseUrl string,
+	queryParams map[string]string,
+	operation string,
+	diagnostics *diag.Diagnostics,
+) []T {
+	values := make([]T, 0)
+
+	for {
+		var page dto.ListResponse[T]
+
+		httpResp, err := newRequest().
+			Method(httpClient.GET).
+			JoinBaseUrl(baseUrl).
+			SetQueryParams(queryParams).
+			SetBodyParseObject(&page).
+			Send()
+
+		handleHttpResponse(httpResp, err, operation, diagnostics, ctx)
+		if diagnostics.HasError() {
+			return nil
+		}
+
+		values = append(values, page.Values...)
+
+		if page.Links.Next == "" {
+			return values
+		}
+		queryParams, err = httpClientHelpers.ParseNextPageQueryParams(page.Links.Next)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to parse next URL, got error: %s", err))
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse next URL, got error: %s", err))
+			return nil
+		}
+	}
+}
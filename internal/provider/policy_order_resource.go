@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// globalPolicyOrderId is the ID of the order of the global alert policies, which do not belong to a team
+const globalPolicyOrderId = "global"
+
+var (
+	_ resource.Resource                = &PolicyOrderResource{}
+	_ resource.ResourceWithConfigure   = &PolicyOrderResource{}
+	_ resource.ResourceWithImportState = &PolicyOrderResource{}
+)
+
+// PolicyOrderResource defines the order in which the alert or notification policies of a team, or the global alert
+// policies, are evaluated
+type PolicyOrderResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+	policyType          string
+}
+
+func NewAlertPolicyOrderResource() resource.Resource {
+	return &PolicyOrderResource{policyType: "alert"}
+}
+
+func NewNotificationPolicyOrderResource() resource.Resource {
+	return &PolicyOrderResource{policyType: "notification"}
+}
+
+// Metadata returns metadata for the resource
+func (r *PolicyOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.policyType + "_policy_order"
+}
+
+// Schema defines the schema for the resource
+func (r *PolicyOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := schemaAttributes.NotificationPolicyOrderResourceAttributes
+	if r.policyType == "alert" {
+		attributes = schemaAttributes.AlertPolicyOrderResourceAttributes
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Order the %s policies. Destroying this resource only removes it from the state, the policies keep their current order.", r.policyType),
+		Attributes:  attributes,
+	}
+}
+
+// Configure sets up the resource with provider configuration
+func (r *PolicyOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring PolicyOrderResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dto.JsmopsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured PolicyOrderResource")
+}
+
+// Create moves the listed policies to the top of the policies
+func (r *PolicyOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating PolicyOrderResource")
+
+	var plan dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyOrder(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "Created PolicyOrderResource")
+}
+
+// Read handles the read operation for the resource
+func (r *PolicyOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Reading PolicyOrderResource")
+
+	policyIds := r.listOrderedPolicyIds(ctx, state.TeamID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the top of the policies is managed, so that a reordering or a policy inserted above the listed policies
+	// shows up as a difference. The whole order is taken on import.
+	if !state.PolicyIDs.IsNull() && len(state.PolicyIDs.Elements()) < len(policyIds) {
+		policyIds = policyIds[:len(state.PolicyIDs.Elements())]
+	}
+
+	policyIdsList, diags := types.ListValueFrom(ctx, types.StringType, policyIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(policyOrderId(state.TeamID.ValueString()))
+	state.PolicyIDs = policyIdsList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update moves the listed policies to their new positions
+func (r *PolicyOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating PolicyOrderResource")
+
+	r.applyOrder(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "Updated PolicyOrderResource")
+}
+
+// Delete only removes the resource from the state, since policies always have an order
+func (r *PolicyOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataModels.PolicyOrderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Removed PolicyOrderResource from the state, the policies keep their order", map[string]interface{}{"id": state.ID.ValueString()})
+}
+
+// ImportState imports the order of all policies of a team by the team ID, or of the global alert policies by "global"
+func (r *PolicyOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" || (req.ID == globalPolicyOrderId && r.policyType != "alert") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team_id, or: %s (for global alert policies). Got: %q", globalPolicyOrderId, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	if req.ID != globalPolicyOrderId {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), req.ID)...)
+	}
+}
+
+func (r *PolicyOrderResource) applyOrder(ctx context.Context, plan *dataModels.PolicyOrderModel, diagnostics *diag.Diagnostics) {
+	teamId := plan.TeamID.ValueString()
+
+	desiredOrder := make([]string, 0)
+	diagnostics.Append(plan.PolicyIDs.ElementsAs(ctx, &desiredOrder, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	currentOrder := r.listOrderedPolicyIds(ctx, teamId, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	for _, policyId := range desiredOrder {
+		if !slices.Contains(currentOrder, policyId) {
+			diagnostics.AddAttributeError(path.Root("policy_ids"), "Invalid Policy",
+				fmt.Sprintf("Policy %s is not one of the %s policies of %s", policyId, r.policyType, policyOrderOwner(teamId)))
+		}
+	}
+	if diagnostics.HasError() {
+		return
+	}
+
+	moveToFront(currentOrder, desiredOrder, func(policyId string, targetIndex int) bool {
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/%s/change-order", policiesBaseUrl(teamId), policyId)).
+			Method(httpClient.POST).
+			SetBody(dto.PolicyChangeOrderDto{TargetIndex: int64(targetIndex)}).
+			Send()
+
+		handleHttpResponse(httpResp, err, fmt.Sprintf("change %s policy order", r.policyType), diagnostics, ctx)
+		return !diagnostics.HasError()
+	})
+
+	plan.ID = types.StringValue(policyOrderId(teamId))
+}
+
+// listOrderedPolicyIds returns the IDs of the policies in their evaluation order
+func (r *PolicyOrderResource) listOrderedPolicyIds(ctx context.Context, teamId string, diagnostics *diag.Diagnostics) []string {
+	queryParams := map[string]string{}
+	if teamId != "" {
+		queryParams["type"] = r.policyType
+	}
+	newRequest := func() *httpClient.Request {
+		return httpClientHelpers.GenerateJsmOpsClientRequest(r.clientConfiguration)
+	}
+	operation := fmt.Sprintf("list %s policies", r.policyType)
+
+	policies := make([]dto.BaseAlertPolicyDto, 0)
+	if r.policyType == "alert" {
+		policies = listAllPages[dto.BaseAlertPolicyDto](ctx, newRequest, policiesBaseUrl(teamId), queryParams, operation, diagnostics)
+	} else {
+		for _, policy := range listAllPages[dto.BaseNotificationPolicyDto](ctx, newRequest, policiesBaseUrl(teamId), queryParams, operation, diagnostics) {
+			policies = append(policies, dto.BaseAlertPolicyDto(policy))
+		}
+	}
+
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	sort.SliceStable(policies, func(i, j int) bool {
+		return policies[i].Order < policies[j].Order
+	})
+
+	policyIds := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		policyIds = append(policyIds, policy.ID)
+	}
+
+	return policyIds
+}
+
+// policiesBaseUrl returns the URL of the policies of the team, or of the global alert policies if teamId is empty
+func policiesBaseUrl(teamId string) string {
+	if teamId == "" {
+		return "/v1/alerts/policies"
+	}
+	return fmt.Sprintf("/v1/teams/%s/policies", teamId)
+}
+
+func policyOrderId(teamId string) string {
+	if teamId == "" {
+		return globalPolicyOrderId
+	}
+	return teamId
+}
+
+func policyOrderOwner(teamId string) string {
+	if teamId == "" {
+		return "the organization"
+	}
+	return "team " + teamId
+}
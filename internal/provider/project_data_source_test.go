@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectDataSource(t *testing.T) {
+	projectKey := os.Getenv("ATLASSIAN_ACCTEST_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if projectKey == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_PROJECT_KEY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_project" "test" {
+	key = "` + projectKey + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the data source
+					// Verify all attributes are set
+					resource.TestCheckResourceAttr("data.atlassian-operations_project.test", "key", projectKey),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_project.test", "id"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_project.test", "name"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_project.test", "project_type_key"),
+					resource.TestCheckResourceAttrSet("data.atlassian-operations_project.test", "self"),
+				),
+			},
+		},
+	})
+}
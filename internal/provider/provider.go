@@ -199,6 +199,24 @@ func (p *atlassianOpsProvider) DataSources(_ context.Context) []func() datasourc
 		NewUserDataSource,
 		NewTeamDataSource,
 		NewScheduleDataSource,
+		NewSchedulesDataSource,
+		NewEscalationDataSource,
+		NewEscalationsDataSource,
+		NewIntegrationDataSource,
+		NewIntegrationsDataSource,
+		NewServiceDataSource,
+		NewServicesDataSource,
+		NewCustomRoleDataSource,
+		NewRoutingRulesDataSource,
+		NewHeartbeatDataSource,
+		NewHeartbeatsDataSource,
+		NewMaintenancesDataSource,
+		NewGroupDataSource,
+		NewProjectDataSource,
+		NewAlertPoliciesDataSource,
+		NewNotificationPoliciesDataSource,
+		NewCurrentUserDataSource,
+		NewUserContactsDataSource,
 	}
 }
 
@@ -207,19 +225,36 @@ func (p *atlassianOpsProvider) Resources(_ context.Context) []func() resource.Re
 	return []func() resource.Resource{
 		NewScheduleRotationResource,
 		NewScheduleResource,
+		NewScheduleOverrideResource,
+		NewForwardingRuleResource,
 		NewTeamResource,
+		NewTeamMembershipResource,
+		NewTeamOpsEnablementResource,
+		NewTeamDefaultRoutingRuleResource,
 		NewEscalationResource,
 		NewEmailIntegrationResource,
 		NewApiIntegrationResource,
 		NewRoutingRuleResource,
+		NewRoutingRuleOrderResource,
 		NewNotificationRuleResource,
+		NewIncomingCallRoutingResource,
 		NewUserContactResource,
 		NewAlertPolicyResource,
+		NewAlertPolicyOrderResource,
 		NewCustomRoleResource,
+		NewUserRoleAssignmentResource,
 		NewNotificationPolicyResource,
+		NewNotificationPolicyOrderResource,
 		NewHeartbeatResource,
 		NewIntegrationActionResource,
 		NewServiceResource,
 		NewMaintenanceResource,
+		NewWebhookIntegrationResource,
+		NewSlackIntegrationResource,
+		NewMicrosoftTeamsIntegrationResource,
+		NewPrometheusIntegrationResource,
+		NewDatadogIntegrationResource,
+		NewCloudWatchIntegrationResource,
+		NewGrafanaIntegrationResource,
 	}
 }
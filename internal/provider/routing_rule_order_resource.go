@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &RoutingRuleOrderResource{}
+	_ resource.ResourceWithConfigure   = &RoutingRuleOrderResource{}
+	_ resource.ResourceWithImportState = &RoutingRuleOrderResource{}
+)
+
+// RoutingRuleOrderResource defines the order in which the routing rules of a team are evaluated
+type RoutingRuleOrderResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewRoutingRuleOrderResource() resource.Resource {
+	return &RoutingRuleOrderResource{}
+}
+
+// Metadata returns metadata for the resource
+func (r *RoutingRuleOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_rule_order"
+}
+
+// Schema defines the schema for the resource
+func (r *RoutingRuleOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Order the routing rules of a team. Destroying this resource only removes it from the state, the routing rules keep their current order.",
+		Attributes:  schemaAttributes.RoutingRuleOrderResourceAttributes,
+	}
+}
+
+// Configure sets up the resource with provider configuration
+func (r *RoutingRuleOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring RoutingRuleOrderResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dto.JsmopsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured RoutingRuleOrderResource")
+}
+
+// Create moves the listed routing rules to the top of the team's routing rules
+func (r *RoutingRuleOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating RoutingRuleOrderResource")
+
+	var plan dataModels.RoutingRuleOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyOrder(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "Created RoutingRuleOrderResource")
+}
+
+// Read handles the read operation for the resource
+func (r *RoutingRuleOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataModels.RoutingRuleOrderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Reading RoutingRuleOrderResource")
+
+	ruleIds, _ := r.listOrderedRuleIds(ctx, state.TeamID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the top of the routing rules is managed, so that a reordering or a rule inserted above the listed rules
+	// shows up as a difference. The whole order is taken on import.
+	if !state.RuleIDs.IsNull() && len(state.RuleIDs.Elements()) < len(ruleIds) {
+		ruleIds = ruleIds[:len(state.RuleIDs.Elements())]
+	}
+
+	ruleIdsList, diags := types.ListValueFrom(ctx, types.StringType, ruleIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = state.TeamID
+	state.RuleIDs = ruleIdsList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update moves the listed routing rules to their new positions
+func (r *RoutingRuleOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataModels.RoutingRuleOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating RoutingRuleOrderResource")
+
+	r.applyOrder(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "Updated RoutingRuleOrderResource")
+}
+
+// Delete only removes the resource from the state, since routing rules always have an order
+func (r *RoutingRuleOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataModels.RoutingRuleOrderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Removed RoutingRuleOrderResource from the state, the routing rules keep their order", map[string]interface{}{"teamId": state.TeamID.ValueString()})
+}
+
+// ImportState imports the order of all routing rules of a team by the team ID
+func (r *RoutingRuleOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("team_id"), req, resp)
+}
+
+func (r *RoutingRuleOrderResource) applyOrder(ctx context.Context, plan *dataModels.RoutingRuleOrderModel, diagnostics *diag.Diagnostics) {
+	teamId := plan.TeamID.ValueString()
+
+	desiredOrder := make([]string, 0)
+	diagnostics.Append(plan.RuleIDs.ElementsAs(ctx, &desiredOrder, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	currentOrder, defaultRuleId := r.listOrderedRuleIds(ctx, teamId, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	for _, ruleId := range desiredOrder {
+		if ruleId == defaultRuleId {
+			diagnostics.AddAttributeError(path.Root("rule_ids"), "Invalid Routing Rule",
+				fmt.Sprintf("Routing rule %s is the default routing rule of team %s, which is always evaluated last and can not be ordered", ruleId, teamId))
+		} else if !slices.Contains(currentOrder, ruleId) {
+			diagnostics.AddAttributeError(path.Root("rule_ids"), "Invalid Routing Rule",
+				fmt.Sprintf("Routing rule %s does not belong to team %s", ruleId, teamId))
+		}
+	}
+	if diagnostics.HasError() {
+		return
+	}
+
+	moveToFront(currentOrder, desiredOrder, func(ruleId string, targetIndex int) bool {
+		httpResp, err := httpClientHelpers.
+			GenerateJsmOpsClientRequest(r.clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/routing-rules/%s/change-order", teamId, ruleId)).
+			Method(httpClient.POST).
+			SetBody(dto.RoutingRuleChangeOrderDto{Order: int64(targetIndex)}).
+			Send()
+
+		handleHttpResponse(httpResp, err, "change routing rule order", diagnostics, ctx)
+		return !diagnostics.HasError()
+	})
+
+	plan.ID = plan.TeamID
+}
+
+// listOrderedRuleIds returns the IDs of the routing rules of the team in their evaluation order, without the default
+// routing rule, whose ID is returned separately.
+func (r *RoutingRuleOrderResource) listOrderedRuleIds(ctx context.Context, teamId string, diagnostics *diag.Diagnostics) ([]string, string) {
+	routingRules := listAllPages[dto.RoutingRuleDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(r.clientConfiguration)
+		},
+		fmt.Sprintf("/v1/teams/%s/routing-rules", teamId),
+		map[string]string{},
+		"list routing rules",
+		diagnostics,
+	)
+
+	if diagnostics.HasError() {
+		return nil, ""
+	}
+
+	sort.SliceStable(routingRules, func(i, j int) bool {
+		return routingRules[i].Order < routingRules[j].Order
+	})
+
+	ruleIds := make([]string, 0, len(routingRules))
+	defaultRuleId := ""
+	for _, rule := range routingRules {
+		if rule.IsDefault {
+			defaultRuleId = rule.ID
+		} else {
+			ruleIds = append(ruleIds, rule.ID)
+		}
+	}
+
+	return ruleIds, defaultRuleId
+}
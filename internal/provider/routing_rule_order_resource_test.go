@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoutingRuleOrderResource(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_routing_rule" "first" {
+  team_id = atlassian-operations_team.example.id
+  name    = "First Routing Rule"
+
+  criteria = {
+    type = "match-all"
+  }
+
+  notify = {
+    type = "none"
+  }
+}
+
+resource "atlassian-operations_routing_rule" "second" {
+  team_id = atlassian-operations_team.example.id
+  name    = "Second Routing Rule"
+
+  criteria = {
+    type = "match-all"
+  }
+
+  notify = {
+    type = "none"
+  }
+}
+
+resource "atlassian-operations_routing_rule_order" "example" {
+  team_id  = atlassian-operations_team.example.id
+  rule_ids = [
+    atlassian-operations_routing_rule.second.id,
+    atlassian-operations_routing_rule.first.id,
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_routing_rule_order.example", "id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_routing_rule_order.example", "rule_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_routing_rule_order.example", "rule_ids.0", "atlassian-operations_routing_rule.second", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_routing_rule_order.example", "rule_ids.1", "atlassian-operations_routing_rule.first", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_routing_rule_order.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_routing_rule_order.example"].Primary.Attributes["team_id"], nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_routing_rule" "first" {
+  team_id = atlassian-operations_team.example.id
+  name    = "First Routing Rule"
+
+  criteria = {
+    type = "match-all"
+  }
+
+  notify = {
+    type = "none"
+  }
+}
+
+resource "atlassian-operations_routing_rule" "second" {
+  team_id = atlassian-operations_team.example.id
+  name    = "Second Routing Rule"
+
+  criteria = {
+    type = "match-all"
+  }
+
+  notify = {
+    type = "none"
+  }
+}
+
+resource "atlassian-operations_routing_rule_order" "example" {
+  team_id  = atlassian-operations_team.example.id
+  rule_ids = [
+    atlassian-operations_routing_rule.first.id,
+    atlassian-operations_routing_rule.second.id,
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_routing_rule_order.example", "rule_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_routing_rule_order.example", "rule_ids.0", "atlassian-operations_routing_rule.first", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_routing_rule_order.example", "rule_ids.1", "atlassian-operations_routing_rule.second", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
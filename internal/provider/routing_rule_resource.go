@@ -132,8 +132,11 @@ func (r *RoutingRuleResource) Update(ctx context.Context, req resource.UpdateReq
 
 	handleHttpResponse(httpResp, err, "update routing rule", &resp.Diagnostics, ctx)
 
-	// Update state
+	// Update state. The planned order is kept, since deleting another rule in the same apply can shift this rule,
+	// and the next read picks up the new order.
+	plannedOrder := data.Order
 	data = RoutingRuleDtoToModel(data.TeamID.ValueString(), ruleDto)
+	data.Order = plannedOrder
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
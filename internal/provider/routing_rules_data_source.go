@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &RoutingRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &RoutingRulesDataSource{}
+)
+
+func NewRoutingRulesDataSource() datasource.DataSource {
+	return &RoutingRulesDataSource{}
+}
+
+// RoutingRulesDataSource defines the data source implementation.
+type RoutingRulesDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *RoutingRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_rules"
+}
+
+func (d *RoutingRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Routing rules data source",
+		Attributes:          schemaAttributes.RoutingRulesDataSourceAttributes,
+	}
+}
+
+func (d *RoutingRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring routing_rules_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure routing_rules_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured routing_rules_data_source")
+}
+
+func (d *RoutingRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataModels.RoutingRulesDataSourceModel
+
+	tflog.Trace(ctx, "Reading routing rules data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read routing rules configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	teamId := data.TeamID.ValueString()
+	rules := listAllPages[dto.RoutingRuleDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(d.clientConfiguration)
+		},
+		fmt.Sprintf("/v1/teams/%s/routing-rules", teamId),
+		map[string]string{},
+		"list routing rules",
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Rules are evaluated in order, so return them the same way regardless of how the API lists them
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Order < rules[j].Order
+	})
+
+	ruleValues := make([]attr.Value, len(rules))
+	for i, rule := range rules {
+		model := RoutingRuleDtoToModel(teamId, rule)
+		ruleValues[i] = model.AsValue()
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("HTTP requests to JSM OPS API Succeeded. Found %d routing rules", len(ruleValues)))
+	data.RoutingRules = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.RoutingRuleModelMap}, ruleValues)
+
+	tflog.Trace(ctx, "Successfully read routing rules data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
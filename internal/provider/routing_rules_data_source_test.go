@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoutingRulesDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	scheduleName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_schedule" "example" {
+  name    = "` + scheduleName + `"
+  team_id = atlassian-operations_team.example.id
+}
+
+resource "atlassian-operations_routing_rule" "example" {
+  team_id  = atlassian-operations_team.example.id
+  name     = "Example Routing Rule"
+  timezone = "Europe/Istanbul"
+
+  criteria = {
+    type = "match-all-conditions"
+    conditions = [
+      {
+        field          = "message"
+        operation      = "matches"
+        expected_value = "my critical alert"
+      }
+    ]
+  }
+
+  notify = {
+    type = "schedule"
+    id   = atlassian-operations_schedule.example.id
+  }
+}
+
+data "atlassian-operations_routing_rules" "test" {
+	depends_on = ["atlassian-operations_routing_rule.example"]
+	team_id = atlassian-operations_team.example.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the data source
+					// The team comes with its default routing rule
+					resource.TestCheckResourceAttr("data.atlassian-operations_routing_rules.test", "routing_rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.atlassian-operations_routing_rules.test", "routing_rules.*", map[string]string{
+						"name":                        "Example Routing Rule",
+						"is_default":                  "false",
+						"timezone":                    "Europe/Istanbul",
+						"criteria.type":               "match-all-conditions",
+						"criteria.conditions.#":       "1",
+						"criteria.conditions.0.field": "message",
+						"notify.type":                 "schedule",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.atlassian-operations_routing_rules.test", "routing_rules.*", map[string]string{
+						"is_default": "true",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.atlassian-operations_routing_rules.test", "routing_rules.*.id", "atlassian-operations_routing_rule.example", "id"),
+				),
+			},
+		},
+	})
+}
@@ -6,12 +6,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -67,8 +71,8 @@ func (d *ScheduleDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *ScheduleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var model dataModels.ScheduleModel
-	var data dto.ListResponse[dto.Schedule]
+	var model dataModels.ScheduleDataSourceModel
+	var schedule dto.Schedule
 
 	tflog.Trace(ctx, "Reading schedule data source from JSM OPS API")
 	// Read Terraform configuration data into the model
@@ -79,36 +83,10 @@ func (d *ScheduleDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	tflog.Trace(ctx, "Sending HTTP request to JSM OPS API")
-
-	clientResp, err := httpClientHelpers.
-		GenerateJsmOpsClientRequest(d.clientConfiguration).
-		Method("GET").
-		JoinBaseUrl("/v1/schedules").
-		SetQueryParams(map[string]string{
-			"query":  model.Name.ValueString(),
-			"size":   "1",
-			"expand": "rotation",
-		}).
-		SetBodyParseObject(&data).
-		Send()
-
-	if err != nil {
-		tflog.Error(ctx, "Sending HTTP request to JSM OPS API Failed")
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got error: %s", err))
-	} else if clientResp.IsError() {
-		statusCode := clientResp.GetStatusCode()
-		errorResponse := clientResp.GetErrorBody()
-		if errorResponse != nil {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read schedule, status code: %d. Got response: %s", statusCode, *errorResponse))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, status code: %d. Got response: %s", statusCode, *errorResponse))
-		} else {
-			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to read schedule, got http response: %d", statusCode))
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got http response: %d", statusCode))
-		}
-	} else if len(data.Values) == 0 {
-		tflog.Error(ctx, "No schedules found")
-		resp.Diagnostics.AddError("Client Error", "No schedules found")
+	if !model.Id.IsNull() {
+		schedule = d.readScheduleById(ctx, model.Id.ValueString(), &resp.Diagnostics)
+	} else {
+		schedule = d.findSchedule(ctx, model, &resp.Diagnostics)
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -116,7 +94,7 @@ func (d *ScheduleDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	tflog.Trace(ctx, "HTTP request to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
-	model = ScheduleDtoToModel(data.Values[0])
+	model = ScheduleDataSourceDtoToModel(schedule, model.IgnoreCase)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -125,3 +103,90 @@ func (d *ScheduleDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
+
+func (d *ScheduleDataSource) readScheduleById(ctx context.Context, scheduleId string, diagnostics *diag.Diagnostics) dto.Schedule {
+	var schedule dto.Schedule
+
+	tflog.Trace(ctx, "Sending HTTP request to JSM OPS API")
+
+	clientResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(d.clientConfiguration).
+		Method(httpClient.GET).
+		JoinBaseUrl(fmt.Sprintf("/v1/schedules/%s", scheduleId)).
+		SetBodyParseObject(&schedule).
+		Send()
+
+	handleHttpResponse(clientResp, err, "read schedule", diagnostics, ctx)
+	if diagnostics.HasError() {
+		return schedule
+	}
+
+	schedule.Rotations = listAllPages[dto.Rotation](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(d.clientConfiguration)
+		},
+		fmt.Sprintf("/v1/schedules/%s/rotations", scheduleId),
+		map[string]string{},
+		"read schedule rotations",
+		diagnostics,
+	)
+	return schedule
+}
+
+// findSchedule pages through the schedules of the site and returns the single schedule matching the name and team
+// given in the configuration. The query parameter of the API is a fuzzy search, so the name is matched exactly here.
+func (d *ScheduleDataSource) findSchedule(ctx context.Context, model dataModels.ScheduleDataSourceModel, diagnostics *diag.Diagnostics) dto.Schedule {
+	schedules := listAllPages[dto.Schedule](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(d.clientConfiguration)
+		},
+		"/v1/schedules",
+		map[string]string{
+			"query":  model.Name.ValueString(),
+			"expand": "rotation",
+		},
+		"read schedule",
+		diagnostics,
+	)
+	if diagnostics.HasError() {
+		return dto.Schedule{}
+	}
+
+	matches := make([]dto.Schedule, 0)
+	for _, schedule := range schedules {
+		if scheduleMatches(schedule, model) {
+			matches = append(matches, schedule)
+		}
+	}
+
+	if len(matches) == 0 {
+		tflog.Error(ctx, "No schedules found")
+		diagnostics.AddError("Client Error", "No schedules found")
+		return dto.Schedule{}
+	} else if len(matches) > 1 {
+		ids := make([]string, len(matches))
+		for i, schedule := range matches {
+			ids[i] = schedule.Id
+		}
+		tflog.Error(ctx, fmt.Sprintf("Multiple schedules found: %s", strings.Join(ids, ", ")))
+		diagnostics.AddError("Client Error",
+			fmt.Sprintf("Multiple schedules found (%s). Please specify team_id or id to narrow down the search.", strings.Join(ids, ", ")))
+		return dto.Schedule{}
+	}
+	return matches[0]
+}
+
+func scheduleMatches(schedule dto.Schedule, model dataModels.ScheduleDataSourceModel) bool {
+	if !model.TeamId.IsNull() && schedule.TeamId != model.TeamId.ValueString() {
+		return false
+	}
+	if model.Name.IsNull() {
+		return true
+	}
+	if model.IgnoreCase.ValueBool() {
+		return strings.EqualFold(schedule.Name, model.Name.ValueString())
+	}
+	return schedule.Name == model.Name.ValueString()
+}
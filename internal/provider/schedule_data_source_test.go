@@ -3,6 +3,7 @@ package provider
 import (
 	"github.com/google/uuid"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -11,6 +12,7 @@ import (
 func TestAccScheduleDataSource(t *testing.T) {
 	teamName := uuid.NewString()
 	scheduleName := uuid.NewString()
+	rotationName := uuid.NewString()
 
 	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
 	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
@@ -51,10 +53,40 @@ resource "atlassian-operations_schedule" "example" {
   team_id = atlassian-operations_team.example.id
 }
 
+resource "atlassian-operations_schedule" "similar" {
+  name    = "` + scheduleName + `-secondary"
+  team_id = atlassian-operations_team.example.id
+}
+
+resource "atlassian-operations_schedule_rotation" "example" {
+  schedule_id = atlassian-operations_schedule.example.id
+  name        = "` + rotationName + `"
+  start_date  = "2023-11-10T05:00:00Z"
+  type        = "weekly"
+  participants = [
+	{
+	  id = data.atlassian-operations_user.test1.account_id
+	  type = "user"
+	}
+  ]
+}
+
 data "atlassian-operations_schedule" "test" {
-	depends_on = ["atlassian-operations_schedule.example"]
+	depends_on = ["atlassian-operations_schedule.example", "atlassian-operations_schedule.similar", "atlassian-operations_schedule_rotation.example"]
 	name = "` + scheduleName + `"
 }
+
+data "atlassian-operations_schedule" "test_ignore_case" {
+	depends_on = ["atlassian-operations_schedule.example", "atlassian-operations_schedule.similar"]
+	name = "` + strings.ToUpper(scheduleName) + `"
+	ignore_case = true
+	team_id = atlassian-operations_team.example.id
+}
+
+data "atlassian-operations_schedule" "test_id" {
+	depends_on = ["atlassian-operations_schedule_rotation.example"]
+	id = atlassian-operations_schedule.example.id
+}
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify the data source
@@ -65,6 +97,15 @@ data "atlassian-operations_schedule" "test" {
 					resource.TestCheckResourceAttrPair("data.atlassian-operations_schedule.test", "timezone", "atlassian-operations_schedule.example", "timezone"),
 					resource.TestCheckResourceAttrPair("data.atlassian-operations_schedule.test", "enabled", "atlassian-operations_schedule.example", "enabled"),
 					resource.TestCheckResourceAttrPair("data.atlassian-operations_schedule.test", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_schedule.test", "rotations.#", "1"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_schedule.test", "rotations.0.id", "atlassian-operations_schedule_rotation.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_schedule.test", "rotations.0.name", rotationName),
+					resource.TestCheckResourceAttr("data.atlassian-operations_schedule.test", "rotations.0.participants.#", "1"),
+					// Verify the case-insensitive lookup
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_schedule.test_ignore_case", "id", "atlassian-operations_schedule.example", "id"),
+					// Verify the lookup by id
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_schedule.test_id", "name", "atlassian-operations_schedule.example", "name"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_schedule.test_id", "rotations.#", "1"),
 				),
 			},
 		},
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &ScheduleOverrideResource{}
+	_ resource.ResourceWithConfigure   = &ScheduleOverrideResource{}
+	_ resource.ResourceWithImportState = &ScheduleOverrideResource{}
+)
+
+// ScheduleOverrideResource defines the resource implementation for schedule overrides
+type ScheduleOverrideResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewScheduleOverrideResource() resource.Resource {
+	return &ScheduleOverrideResource{}
+}
+
+// Metadata returns metadata for the resource
+func (r *ScheduleOverrideResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_override"
+}
+
+// Schema defines the schema for the resource
+func (r *ScheduleOverrideResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage on-call schedule overrides in Atlassian Operations. Overrides that have ended are removed from the state.",
+		Attributes:  schemaAttributes.ScheduleOverrideResourceAttributes,
+	}
+}
+
+// Configure sets up the resource with provider configuration
+func (r *ScheduleOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring ScheduleOverrideResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dto.JsmopsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured ScheduleOverrideResource")
+}
+
+// Create handles the create operation for the resource
+func (r *ScheduleOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating ScheduleOverrideResource")
+
+	var plan dataModels.ScheduleOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	overrideDto := ScheduleOverrideModelToDto(ctx, plan)
+	scheduleId := plan.ScheduleId.ValueString()
+
+	var createdDto dto.ScheduleOverride
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s/overrides", scheduleId)).
+		Method(httpClient.POST).
+		SetBody(overrideDto).
+		SetBodyParseObject(&createdDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "create schedule override", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The server only returns the alias of the created override
+	result, found := r.readScheduleOverride(ctx, scheduleId, createdDto.Alias, &resp.Diagnostics)
+	if !(resp.Diagnostics.HasError() || found) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schedule override, the override %s was not found after saving it", createdDto.Alias))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := ScheduleOverrideDtoToModel(scheduleId, *result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "Created ScheduleOverrideResource")
+}
+
+// Read handles the read operation for the resource
+func (r *ScheduleOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataModels.ScheduleOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Reading ScheduleOverrideResource")
+
+	scheduleId := state.ScheduleId.ValueString()
+
+	result, found := r.readScheduleOverride(ctx, scheduleId, state.Id.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found || hasDatePassed(result.EndDate) {
+		tflog.Debug(ctx, fmt.Sprintf("Schedule override %s is not found or has ended, removing it from the state", state.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data, diags := ScheduleOverrideDtoToModel(scheduleId, *result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update handles the update operation for the resource
+func (r *ScheduleOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataModels.ScheduleOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating ScheduleOverrideResource")
+
+	overrideDto := ScheduleOverrideModelToDto(ctx, plan)
+	scheduleId := plan.ScheduleId.ValueString()
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s/overrides/%s", scheduleId, plan.Id.ValueString())).
+		Method(httpClient.PUT).
+		SetBody(overrideDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update schedule override", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, found := r.readScheduleOverride(ctx, scheduleId, plan.Id.ValueString(), &resp.Diagnostics)
+	if !(resp.Diagnostics.HasError() || found) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schedule override, the override %s was not found after saving it", plan.Id.ValueString()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := ScheduleOverrideDtoToModel(scheduleId, *result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "Updated ScheduleOverrideResource")
+}
+
+// Delete handles the delete operation for the resource
+func (r *ScheduleOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataModels.ScheduleOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting ScheduleOverrideResource")
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s/overrides/%s", state.ScheduleId.ValueString(), state.Id.ValueString())).
+		Method(httpClient.DELETE).
+		Send()
+
+	// The override may have been deleted or expired in the meantime
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return
+	}
+
+	handleHttpResponse(httpResp, err, "delete schedule override", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted ScheduleOverrideResource")
+}
+
+// ImportState handles importing the state of an existing resource
+func (r *ScheduleOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: alias,schedule_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_id"), idParts[1])...)
+}
+
+// readScheduleOverride fetches an override of a schedule and reports whether it exists
+func (r *ScheduleOverrideResource) readScheduleOverride(ctx context.Context, scheduleId string, alias string, diags *diag.Diagnostics) (*dto.ScheduleOverride, bool) {
+	var overrideDto dto.ScheduleOverride
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/schedules/%s/overrides/%s", scheduleId, alias)).
+		Method(httpClient.GET).
+		SetBodyParseObject(&overrideDto).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return nil, false
+	}
+
+	handleHttpResponse(httpResp, err, "read schedule override", diags, ctx)
+	if diags.HasError() {
+		return nil, false
+	}
+
+	return &overrideDto, true
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduleOverrideResource(t *testing.T) {
+	overrideAlias := uuid.NewString()
+	rotationName := uuid.NewString()
+	scheduleName := uuid.NewString()
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_schedule" "example" {
+  name    = "` + scheduleName + `"
+  team_id = atlassian-operations_team.example.id
+}
+
+resource "atlassian-operations_schedule_rotation" "example" {
+  schedule_id = atlassian-operations_schedule.example.id
+  name       = "` + rotationName + `"
+  start_date = "2030-01-01T00:00:00Z"
+  type       = "weekly"
+  participants = [
+	{
+	  id = data.atlassian-operations_user.test1.account_id
+	  type = "user"
+	}
+  ]
+}
+
+resource "atlassian-operations_schedule_override" "example" {
+  schedule_id = atlassian-operations_schedule.example.id
+  alias       = "` + overrideAlias + `"
+  start_date  = "2030-01-10T09:00:00Z"
+  end_date    = "2030-01-12T09:00:00Z"
+  responder = {
+	id = data.atlassian-operations_user.test1.account_id
+	type = "user"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_schedule_override.example", "alias", overrideAlias),
+					resource.TestCheckResourceAttr("atlassian-operations_schedule_override.example", "id", overrideAlias),
+					resource.TestCheckResourceAttrPair("atlassian-operations_schedule_override.example", "schedule_id", "atlassian-operations_schedule.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_schedule_override.example", "start_date", "2030-01-10T09:00:00Z"),
+					resource.TestCheckResourceAttr("atlassian-operations_schedule_override.example", "end_date", "2030-01-12T09:00:00Z"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_schedule_override.example", "responder.id", "data.atlassian-operations_user.test1", "account_id"),
+					resource.TestCheckResourceAttr("atlassian-operations_schedule_override.example", "responder.type", "user"),
+					resource.TestCheckResourceAttr("atlassian-operations_schedule_override.example", "rotation_ids.#", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_schedule_override.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_schedule_override.example"].Primary.ID +
+							"," +
+							state.RootModule().Resources["atlassian-operations_schedule_override.example"].Primary.Attributes["schedule_id"],
+						nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_schedule" "example" {
+  name    = "` + scheduleName + `"
+  team_id = atlassian-operations_team.example.id
+}
+
+resource "atlassian-operations_schedule_rotation" "example" {
+  schedule_id = atlassian-operations_schedule.example.id
+  name       = "` + rotationName + `"
+  start_date = "2030-01-01T00:00:00Z"
+  type       = "weekly"
+  participants = [
+	{
+	  id = data.atlassian-operations_user.test1.account_id
+	  type = "user"
+	}
+  ]
+}
+
+resource "atlassian-operations_schedule_override" "example" {
+  schedule_id = atlassian-operations_schedule.example.id
+  alias       = "` + overrideAlias + `"
+  start_date  = "2030-01-11T09:00:00Z"
+  end_date    = "2030-01-14T09:00:00Z"
+  responder = {
+	id = atlassian-operations_team.example.id
+	type = "team"
+  }
+  rotation_ids = [atlassian-operations_schedule_rotation.example.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_schedule_override.example", "alias", overrideAlias),
+					resource.TestCheckResourceAttr("atlassian-operations_schedule_override.example", "start_date", "2030-01-11T09:00:00Z"),
+					resource.TestCheckResourceAttr("atlassian-operations_schedule_override.example", "end_date", "2030-01-14T09:00:00Z"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_schedule_override.example", "responder.id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_schedule_override.example", "responder.type", "team"),
+					resource.TestCheckResourceAttr("atlassian-operations_schedule_override.example", "rotation_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("atlassian-operations_schedule_override.example", "rotation_ids.*", "atlassian-operations_schedule_rotation.example", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
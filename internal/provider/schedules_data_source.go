@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &SchedulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &SchedulesDataSource{}
+)
+
+func NewSchedulesDataSource() datasource.DataSource {
+	return &SchedulesDataSource{}
+}
+
+// SchedulesDataSource defines the data source implementation.
+type SchedulesDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *SchedulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedules"
+}
+
+func (d *SchedulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Schedules data source",
+		Attributes:          schemaAttributes.SchedulesDataSourceAttributes,
+	}
+}
+
+func (d *SchedulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring schedules_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure schedules_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured schedules_data_source")
+}
+
+func (d *SchedulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataModels.SchedulesDataSourceModel
+	var nameRegex *regexp.Regexp
+
+	tflog.Trace(ctx, "Reading schedules data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read schedules configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	if !model.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(model.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Unable to compile name_regex, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	schedules := listAllPages[dto.Schedule](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(d.clientConfiguration)
+		},
+		"/v1/schedules",
+		map[string]string{},
+		"list schedules",
+		&resp.Diagnostics,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP requests to JSM OPS API Succeeded. Parsing the fetched data to Terraform model")
+
+	scheduleValues := make([]attr.Value, 0)
+	for _, schedule := range schedules {
+		if !model.TeamId.IsNull() && schedule.TeamId != model.TeamId.ValueString() {
+			continue
+		}
+		if !model.Enabled.IsNull() && schedule.Enabled != model.Enabled.ValueBool() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(schedule.Name) {
+			continue
+		}
+		scheduleModel := ScheduleDtoToModel(schedule)
+		scheduleValues = append(scheduleValues, scheduleModel.AsValue())
+	}
+	model.Schedules = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.ScheduleModelMap}, scheduleValues)
+
+	tflog.Trace(ctx, "Successfully read schedules data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchedulesDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	scheduleName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  delete_default_resources = true
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_schedule" "first" {
+  name    = "` + scheduleName + `-first"
+  team_id = atlassian-operations_team.example.id
+}
+
+resource "atlassian-operations_schedule" "second" {
+  name    = "` + scheduleName + `-second"
+  team_id = atlassian-operations_team.example.id
+  enabled = false
+}
+
+data "atlassian-operations_schedules" "team" {
+	depends_on = ["atlassian-operations_schedule.first", "atlassian-operations_schedule.second"]
+	team_id = atlassian-operations_team.example.id
+}
+
+data "atlassian-operations_schedules" "enabled" {
+	depends_on = ["atlassian-operations_schedule.first", "atlassian-operations_schedule.second"]
+	team_id = atlassian-operations_team.example.id
+	enabled = true
+}
+
+data "atlassian-operations_schedules" "regex" {
+	depends_on = ["atlassian-operations_schedule.first", "atlassian-operations_schedule.second"]
+	name_regex = "^` + scheduleName + `-sec"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.atlassian-operations_schedules.team", "schedules.#", "2"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_schedules.enabled", "schedules.#", "1"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_schedules.enabled", "schedules.0.id", "atlassian-operations_schedule.first", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_schedules.regex", "schedules.#", "1"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_schedules.regex", "schedules.0.id", "atlassian-operations_schedule.second", "id"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_schedules.regex", "schedules.0.team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_schedules.regex", "schedules.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
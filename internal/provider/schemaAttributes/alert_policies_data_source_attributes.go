@@ -0,0 +1,55 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var AlertPoliciesDataSourceAttributes = map[string]schema.Attribute{
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team whose alert policies are listed. If not set, the global alert policies are listed.",
+		Optional:    true,
+	},
+	"name_regex": schema.StringAttribute{
+		Description: "Only return alert policies whose name matches this regular expression (Go RE2 syntax).",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"alert_policies": schema.ListNestedAttribute{
+		Description: "The alert policies matching the given filters, in the order returned by the API.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: PolicySummaryDataSourceAttributes,
+		},
+	},
+}
+
+var PolicySummaryDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the policy.",
+		Computed:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the policy. Null for global policies.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the policy.",
+		Computed:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the policy. Always 'alert'.",
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the policy is enabled.",
+		Computed:    true,
+	},
+	"order": schema.Int64Attribute{
+		Description: "The order of the policy within the policies of its owner. Policies are evaluated from the lowest order.",
+		Computed:    true,
+	},
+}
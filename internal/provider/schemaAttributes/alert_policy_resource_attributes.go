@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -46,9 +47,11 @@ var AlertPolicyResourceAttributes = map[string]schema.Attribute{
 		Description: "Whether the alert policy is enabled",
 	},
 	"order": schema.Int64Attribute{
-		Optional:    true,
 		Computed:    true,
-		Description: "The order of the alert policy",
+		Description: "The order of the alert policy. The order is managed with the `atlassian-operations_alert_policy_order` resource.",
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
 	},
 	"filter": schema.SingleNestedAttribute{
 		Optional:    true,
@@ -1,7 +1,7 @@
 package schemaAttributes
 
 import (
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -78,8 +78,8 @@ var ApiIntegrationResourceAttributes = map[string]schema.Attribute{
 		Optional:    false,
 	},
 	"type_specific_properties": schema.StringAttribute{
-		Description: "JSON object containing integration-specific configuration properties. The schema depends on the integration type.",
-		CustomType:  jsontypes.ExactType{},
+		Description: "JSON object containing integration-specific configuration properties. The schema depends on the integration type. Properties the server adds with default values, null properties and ordering differences do not cause a diff.",
+		CustomType:  customTypes.LenientJsonType{},
 		Computed:    true,
 		Optional:    true,
 	},
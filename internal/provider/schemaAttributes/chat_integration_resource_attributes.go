@@ -0,0 +1,175 @@
+package schemaAttributes
+
+import (
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var SlackIntegrationResourceAttributes = chatIntegrationResourceAttributes(
+	"Slack",
+	"The Slack workspace the alerts are posted to.",
+	"The Slack channel the alerts are posted to (e.g., '#alerts').",
+)
+
+var MicrosoftTeamsIntegrationResourceAttributes = chatIntegrationResourceAttributes(
+	"Microsoft Teams",
+	"The Microsoft Teams team the alerts are posted to.",
+	"The channel of the Microsoft Teams team the alerts are posted to.",
+)
+
+// chatIntegrationResourceAttributes returns the schema shared by the chat integrations, which only differ in the
+// product they post to.
+func chatIntegrationResourceAttributes(product string, workspaceDescription string, channelDescription string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: fmt.Sprintf("The unique identifier of the %s integration. This is automatically generated when the integration is created.", product),
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: fmt.Sprintf("The name of the %s integration. Must be between 1 and 250 characters.", product),
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 250),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Description: fmt.Sprintf("Whether the %s integration is enabled. When disabled, no alerts are forwarded. Defaults to true.", product),
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+		"team_id": schema.StringAttribute{
+			Description: fmt.Sprintf("The ID of the team that owns this %s integration. Used for access control and organization.", product),
+			Optional:    true,
+			Computed:    true,
+		},
+		"advanced": schema.BoolAttribute{
+			Description: fmt.Sprintf("Indicates whether this is an advanced %s integration with additional configuration options.", product),
+			Computed:    true,
+		},
+		"maintenance_sources": schema.ListNestedAttribute{
+			Description: fmt.Sprintf("List of maintenance windows associated with this %s integration. These define when the integration is under maintenance.", product),
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ApiIntegrationResourceMaintenanceSourceAttributes,
+			},
+		},
+		"directions": schema.ListAttribute{
+			Description: "The communication directions supported by this integration (e.g., 'incoming', 'outgoing').",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"domains": schema.ListAttribute{
+			Description: "The domains this integration operates on (e.g., 'alert').",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"type_specific_properties": schema.SingleNestedAttribute{
+			Description: fmt.Sprintf("Configuration properties specific to %s integrations, such as the channel to post to and the alert actions to forward.", product),
+			Required:    true,
+			Attributes: map[string]schema.Attribute{
+				"workspace": schema.StringAttribute{
+					Description: workspaceDescription,
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"channel": schema.StringAttribute{
+					Description: channelDescription,
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"alert_actions": schema.SetAttribute{
+					Description: fmt.Sprintf("The alert actions forwarded to %s (e.g., 'Create', 'Acknowledge', 'Close').", product),
+					ElementType: types.StringType,
+					Required:    true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+						setvalidator.ValueStringsAre(
+							stringvalidator.OneOf(
+								"Create", "Acknowledge", "UnAcknowledge", "Close", "Delete", "AddNote", "TakeOwnership",
+								"AssignOwnership", "AddTags", "RemoveTags", "AddDetails", "RemoveDetails", "UpdatePriority",
+								"UpdateMessage", "UpdateDescription", "Snooze", "EscalateToNext", "AddResponder", "AddTeam",
+							),
+						),
+					},
+				},
+				"filter": schema.SingleNestedAttribute{
+					Description: "The conditions an alert must match to be forwarded. All alerts are forwarded when not set.",
+					Optional:    true,
+					Computed:    true,
+					Validators: []validator.Object{
+						customValidators.ListFieldNullIfOtherField(path.MatchRelative().AtName("conditions"), path.MatchRelative().AtName("type"), "match-all"),
+						customValidators.ListFieldNotNullIfOtherField(path.MatchRelative().AtName("conditions"), path.MatchRelative().AtName("type"), "match-all-conditions"),
+						customValidators.ListFieldNotNullIfOtherField(path.MatchRelative().AtName("conditions"), path.MatchRelative().AtName("type"), "match-any-condition"),
+					},
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of filter matching to use. Valid values are: 'match-all' (forwards all alerts), 'match-all-conditions' (all conditions must match), or 'match-any-condition' (any condition can match).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("match-all", "match-any-condition", "match-all-conditions"),
+							},
+						},
+						"conditions": schema.ListNestedAttribute{
+							Description: "List of conditions an alert must meet to be forwarded. Required if type is 'match-all-conditions' or 'match-any-condition'.",
+							Optional:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"field": schema.StringAttribute{
+										Description: "The alert field to evaluate (e.g., 'message', 'priority', 'tags').",
+										Required:    true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("message", "alias", "description", "source", "entity", "tags", "actions", "extra-properties", "priority", "details", "responders"),
+										},
+									},
+									"operation": schema.StringAttribute{
+										Description: "The comparison operation to perform (e.g., 'equals', 'contains', 'matches').",
+										Required:    true,
+									},
+									"expected_value": schema.StringAttribute{
+										Description: "The value to compare against the field value.",
+										Optional:    true,
+										Computed:    true,
+									},
+									"key": schema.StringAttribute{
+										Description: "If field is set as extra-properties, key could be used for key-value pair.",
+										Optional:    true,
+										Computed:    true,
+									},
+									"not": schema.BoolAttribute{
+										Description: "Indicates behaviour of the given operation.",
+										Optional:    true,
+										Computed:    true,
+										Default:     booldefault.StaticBool(false),
+									},
+									"order": schema.Int64Attribute{
+										Description: "Order of the condition in conditions list.",
+										Optional:    true,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
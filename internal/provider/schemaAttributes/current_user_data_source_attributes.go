@@ -0,0 +1,20 @@
+package schemaAttributes
+
+import (
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// CurrentUserDataSourceAttributes mirrors UserDataSourceAttributes, except that the email address is not an input
+// since the user is resolved from the provider credentials.
+var CurrentUserDataSourceAttributes = currentUserDataSourceAttributes()
+
+func currentUserDataSourceAttributes() map[string]schema.Attribute {
+	attributes := maps.Clone(UserDataSourceAttributes)
+	attributes["email_address"] = schema.StringAttribute{
+		Description: "The email address of the user the provider is authenticated as.",
+		Computed:    true,
+	}
+	return attributes
+}
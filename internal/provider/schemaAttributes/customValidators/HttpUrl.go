@@ -0,0 +1,41 @@
+package customValidators
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &httpUrlValidator{}
+
+type httpUrlValidator struct{}
+
+func (s httpUrlValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	parsedUrl, err := url.Parse(value)
+	if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Attribute",
+			fmt.Sprintf("The value '%s' must be an absolute http or https URL", value),
+		)
+	}
+}
+
+func (s httpUrlValidator) Description(_ context.Context) string {
+	return "The value must be an absolute http or https URL"
+}
+
+func (s httpUrlValidator) MarkdownDescription(ctx context.Context) string {
+	return s.Description(ctx)
+}
+
+func HttpUrl() validator.String {
+	return &httpUrlValidator{}
+}
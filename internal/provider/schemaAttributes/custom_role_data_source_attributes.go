@@ -0,0 +1,32 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var CustomRoleDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the custom role.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the custom role. Only the custom role whose name matches exactly is returned.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"granted_rights": schema.SetAttribute{
+		Description: "The rights granted to users with this custom role.",
+		Computed:    true,
+		ElementType: types.StringType,
+	},
+	"disallowed_rights": schema.SetAttribute{
+		Description: "The rights explicitly denied to users with this custom role.",
+		Computed:    true,
+		ElementType: types.StringType,
+	},
+}
@@ -0,0 +1,98 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var EscalationDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the escalation policy.",
+		Computed:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the escalation policy. Used to look up the escalation policy.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description: "The exact name of the escalation policy within the team. Used to look up the escalation policy.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"description": schema.StringAttribute{
+		Description: "A detailed description of the escalation policy's purpose and behavior.",
+		Computed:    true,
+	},
+	"rules": schema.SetNestedAttribute{
+		Description: "List of escalation rules that define how and when to escalate alerts.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: EscalationRulesResponseDataSourceAttributes,
+		},
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the escalation policy is active.",
+		Computed:    true,
+	},
+	"repeat": schema.SingleNestedAttribute{
+		Description: "Configuration for repeating escalations, including intervals, counts, and state management.",
+		Computed:    true,
+		Attributes:  EscalationRepeatDataSourceAttributes,
+	},
+}
+
+var EscalationRepeatDataSourceAttributes = map[string]schema.Attribute{
+	"wait_interval": schema.Int32Attribute{
+		Description: "The time to wait (in minutes) before repeating the escalation rules.",
+		Computed:    true,
+	},
+	"count": schema.Int32Attribute{
+		Description: "The number of times to repeat the escalation rules.",
+		Computed:    true,
+	},
+	"reset_recipient_states": schema.BoolAttribute{
+		Description: "Whether acknowledgment and seen states for recipients are reset on each repeat cycle.",
+		Computed:    true,
+	},
+	"close_alert_after_all": schema.BoolAttribute{
+		Description: "Whether the alert is automatically closed after all repeat cycles are completed.",
+		Computed:    true,
+	},
+}
+
+var EscalationRulesResponseDataSourceAttributes = map[string]schema.Attribute{
+	"condition": schema.StringAttribute{
+		Description: "The condition that triggers this escalation rule. Either 'if-not-acked' or 'if-not-closed'.",
+		Computed:    true,
+	},
+	"notify_type": schema.StringAttribute{
+		Description: "How recipients are selected for notification (e.g., 'default', 'next', 'all').",
+		Computed:    true,
+	},
+	"delay": schema.Int64Attribute{
+		Description: "The time to wait (in minutes) before executing this escalation rule.",
+		Computed:    true,
+	},
+	"recipient": schema.SingleNestedAttribute{
+		Description: "The target recipient for this escalation rule.",
+		Computed:    true,
+		Attributes:  EscalationRuleRecipientDataSourceAttributes,
+	},
+}
+
+var EscalationRuleRecipientDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the recipient (user ID, schedule ID, or team ID).",
+		Computed:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of recipient. One of 'user', 'schedule' or 'team'.",
+		Computed:    true,
+	},
+}
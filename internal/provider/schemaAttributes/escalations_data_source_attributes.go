@@ -0,0 +1,59 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var EscalationsDataSourceAttributes = map[string]schema.Attribute{
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team whose escalation policies are listed.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"escalations": schema.ListNestedAttribute{
+		Description: "The escalation policies of the team.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: EscalationSummaryDataSourceAttributes,
+		},
+	},
+}
+
+var EscalationSummaryDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the escalation policy.",
+		Computed:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the escalation policy.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the escalation policy.",
+		Computed:    true,
+	},
+	"description": schema.StringAttribute{
+		Description: "A detailed description of the escalation policy's purpose and behavior.",
+		Computed:    true,
+	},
+	"rules": schema.SetNestedAttribute{
+		Description: "List of escalation rules that define how and when to escalate alerts.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: EscalationRulesResponseDataSourceAttributes,
+		},
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the escalation policy is active.",
+		Computed:    true,
+	},
+	"repeat": schema.SingleNestedAttribute{
+		Description: "Configuration for repeating escalations, including intervals, counts, and state management.",
+		Computed:    true,
+		Attributes:  EscalationRepeatDataSourceAttributes,
+	},
+}
@@ -0,0 +1,73 @@
+package schemaAttributes
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var ForwardingRuleResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the forwarding rule.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"alias": schema.StringAttribute{
+		Description: "A user defined identifier of the forwarding rule. Generated by the server if not specified.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"from_user_id": schema.StringAttribute{
+		Description: "The account ID of the user whose notifications are forwarded.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"to": schema.SingleNestedAttribute{
+		Description: "The user or team that receives the forwarded notifications.",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user or team.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the recipient. Valid values are 'user' and 'team'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "team"),
+				},
+			},
+		},
+	},
+	"start_date": schema.StringAttribute{
+		Description: "The date and time when the forwarding begins, in RFC3339 format (e.g., '2024-01-01T00:00:00Z').",
+		Required:    true,
+	},
+	"end_date": schema.StringAttribute{
+		Description: "The date and time when the forwarding ends, in RFC3339 format. The forwarding continues indefinitely if not specified. The forwarding rule is removed from the state once this date has passed.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, request planmodifier.StringRequest, response *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+				// The end date of an existing forwarding rule can be changed, but not removed
+				if !request.StateValue.IsNull() && request.ConfigValue.IsNull() {
+					response.RequiresReplace = true
+				}
+			},
+				"Force replacement since end_date is removed",
+				"Force replacement since end_date is removed"),
+		},
+	},
+}
@@ -0,0 +1,21 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var GroupDataSourceAttributes = map[string]schema.Attribute{
+	"name": schema.StringAttribute{
+		Description: "The name of the group. Only the group whose name matches exactly is returned.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"group_id": schema.StringAttribute{
+		Description: "The unique identifier for the group. This is the ID expected by the change approvers of a service.",
+		Computed:    true,
+	},
+}
@@ -0,0 +1,68 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var HeartbeatDataSourceAttributes = map[string]schema.Attribute{
+	"name": schema.StringAttribute{
+		Description: "The name of the heartbeat.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the heartbeat.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"description": schema.StringAttribute{
+		Description: "Description of the heartbeat.",
+		Computed:    true,
+	},
+	"interval": schema.Int64Attribute{
+		Description: "The interval value for the heartbeat check.",
+		Computed:    true,
+	},
+	"interval_unit": schema.StringAttribute{
+		Description: "The unit for the interval (e.g., 'minutes', 'hours', 'days').",
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the heartbeat is enabled or not.",
+		Computed:    true,
+	},
+	"status": schema.StringAttribute{
+		Description: "The current status of the heartbeat.",
+		Computed:    true,
+	},
+	"last_ping_time": schema.StringAttribute{
+		Description: "The time the last ping was received, in RFC3339 format. Null if the heartbeat has never been pinged.",
+		Computed:    true,
+		CustomType:  timetypes.RFC3339Type{},
+	},
+	"expired": schema.BoolAttribute{
+		Description: "Whether the heartbeat is expired, i.e. no ping has been received within the configured interval.",
+		Computed:    true,
+	},
+	"alert_message": schema.StringAttribute{
+		Description: "The message to be displayed when an alert is triggered due to missed heartbeat.",
+		Computed:    true,
+	},
+	"alert_tags": schema.SetAttribute{
+		Description: "Tags to be associated with the alert when triggered.",
+		Computed:    true,
+		ElementType: types.StringType,
+	},
+	"alert_priority": schema.StringAttribute{
+		Description: "The priority of the alert to be created when heartbeat is missed (e.g., 'P1', 'P2').",
+		Computed:    true,
+	},
+}
@@ -0,0 +1,113 @@
+package schemaAttributes
+
+import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var IncomingCallRoutingResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the incoming call routing.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The unique identifier of the team that receives the calls.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the incoming call routing.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"phone_number": schema.StringAttribute{
+		Description: "The phone number the calls are made to, in E.164 format (e.g., '+14155550100'). A number is assigned by the server if not specified.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"greeting": schema.StringAttribute{
+		Description: "The message read to the callers before their call is routed.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"routing_rules": schema.ListNestedAttribute{
+		Description: "The rules evaluated in order for every call. The call is routed to the recipient of the first rule whose time restriction matches.",
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "The name of the routing rule.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"time_restriction": schema.SingleNestedAttribute{
+					Description: "The time windows in which the rule matches. The rule matches at any time if not specified.",
+					Optional:    true,
+					Attributes:  TimeRestrictionResourceAttributes,
+				},
+				"recipient": incomingCallRecipientAttribute(
+					"The team, escalation or schedule the calls matching the rule are routed to.",
+					true,
+					"team", "escalation", "schedule",
+				),
+			},
+		},
+	},
+	"fallback": incomingCallRecipientAttribute(
+		"Where the calls go when no routing rule matches or nobody answers. Valid types are 'team', 'escalation', 'schedule' and 'voicemail'. The call is ended if not specified.",
+		false,
+		"team", "escalation", "schedule", "voicemail",
+	),
+}
+
+func incomingCallRecipientAttribute(description string, required bool, recipientTypes ...string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Required:    required,
+		Optional:    !required,
+		Validators: []validator.Object{
+			customValidators.StringFieldNotNullIfOtherField(path.MatchRelative().AtName("id"), path.MatchRelative().AtName("type"), "team"),
+			customValidators.StringFieldNotNullIfOtherField(path.MatchRelative().AtName("id"), path.MatchRelative().AtName("type"), "escalation"),
+			customValidators.StringFieldNotNullIfOtherField(path.MatchRelative().AtName("id"), path.MatchRelative().AtName("type"), "schedule"),
+		},
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "The type of the recipient.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(recipientTypes...),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the team, escalation or schedule. Required unless the type is 'voicemail'.",
+				Optional:    true,
+			},
+		},
+	}
+}
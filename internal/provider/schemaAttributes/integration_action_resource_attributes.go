@@ -1,8 +1,8 @@
 package schemaAttributes
 
 import (
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/customTypes"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -130,13 +130,13 @@ var IntegrationActionResourceAttributes = map[string]schema.Attribute{
 		},
 	},
 	"type_specific_properties": schema.StringAttribute{
-		CustomType:  jsontypes.ExactType{},
+		CustomType:  customTypes.LenientJsonType{},
 		Description: "Type-specific properties for the integration action",
 		Optional:    true,
 		Computed:    true,
 	},
 	"field_mappings": schema.StringAttribute{
-		CustomType:  jsontypes.ExactType{},
+		CustomType:  customTypes.LenientJsonType{},
 		Description: "Field mappings for the integration action",
 		Optional:    true,
 		Computed:    true,
@@ -151,7 +151,7 @@ var IntegrationActionResourceAttributes = map[string]schema.Attribute{
 				Required:    true,
 			},
 			"parameter": schema.StringAttribute{
-				CustomType:  jsontypes.ExactType{},
+				CustomType:  customTypes.LenientJsonType{},
 				Description: "Parameters for the action mapping",
 				Optional:    true,
 				Computed:    true,
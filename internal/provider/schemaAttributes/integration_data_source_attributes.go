@@ -0,0 +1,90 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var IntegrationDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the integration.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The exact name of the integration. Used to look up the integration.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 250),
+		},
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the integration (e.g., 'API', 'Email'). When set, only integrations of this type are considered.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the integration. When set, only integrations of this team are considered.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the integration is enabled.",
+		Computed:    true,
+	},
+	"advanced": schema.BoolAttribute{
+		Description: "Indicates whether this is an advanced integration with additional configuration options.",
+		Computed:    true,
+	},
+	"maintenance_sources": schema.ListNestedAttribute{
+		Description: "List of maintenance windows associated with this integration.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: IntegrationMaintenanceSourceDataSourceAttributes,
+		},
+	},
+	"directions": schema.ListAttribute{
+		Description: "List of supported communication directions for this integration (e.g., 'inbound', 'outbound').",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"domains": schema.ListAttribute{
+		Description: "List of domains associated with this integration.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"type_specific_properties": schema.StringAttribute{
+		Description: "JSON object containing integration-specific configuration properties. The schema depends on the integration type. Secrets, such as request headers, keys, tokens and passwords, are left out.",
+		CustomType:  jsontypes.ExactType{},
+		Computed:    true,
+	},
+}
+
+var IntegrationMaintenanceSourceDataSourceAttributes = map[string]schema.Attribute{
+	"maintenance_id": schema.StringAttribute{
+		Description: "The unique identifier of the maintenance window.",
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the maintenance window is active.",
+		Computed:    true,
+	},
+	"interval": schema.SingleNestedAttribute{
+		Description: "The time interval during which the maintenance window is active.",
+		Computed:    true,
+		Attributes:  IntegrationMaintenanceSourceIntervalDataSourceAttributes,
+	},
+}
+
+var IntegrationMaintenanceSourceIntervalDataSourceAttributes = map[string]schema.Attribute{
+	"start_time_millis": schema.Int64Attribute{
+		Description: "The start time of the maintenance window in Unix milliseconds (UTC).",
+		Computed:    true,
+	},
+	"end_time_millis": schema.Int64Attribute{
+		Description: "The end time of the maintenance window in Unix milliseconds (UTC).",
+		Computed:    true,
+	},
+}
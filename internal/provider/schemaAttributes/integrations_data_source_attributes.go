@@ -0,0 +1,78 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var IntegrationsDataSourceAttributes = map[string]schema.Attribute{
+	"type": schema.StringAttribute{
+		Description: "Only return integrations of this type (e.g., 'API', 'Email').",
+		Optional:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "Only return integrations owned by the team with this ID.",
+		Optional:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Only return integrations that are enabled (true) or disabled (false).",
+		Optional:    true,
+	},
+	"integrations": schema.ListNestedAttribute{
+		Description: "The integrations matching the given filters.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: IntegrationSummaryDataSourceAttributes,
+		},
+	},
+}
+
+var IntegrationSummaryDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the integration.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the integration.",
+		Computed:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the integration.",
+		Computed:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the integration.",
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the integration is enabled.",
+		Computed:    true,
+	},
+	"advanced": schema.BoolAttribute{
+		Description: "Indicates whether this is an advanced integration with additional configuration options.",
+		Computed:    true,
+	},
+	"maintenance_sources": schema.ListNestedAttribute{
+		Description: "List of maintenance windows associated with this integration.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: IntegrationMaintenanceSourceDataSourceAttributes,
+		},
+	},
+	"directions": schema.ListAttribute{
+		Description: "List of supported communication directions for this integration.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"domains": schema.ListAttribute{
+		Description: "List of domains associated with this integration.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"type_specific_properties": schema.StringAttribute{
+		Description: "JSON object containing integration-specific configuration properties. Secrets, such as request headers, keys, tokens and passwords, are left out.",
+		CustomType:  jsontypes.ExactType{},
+		Computed:    true,
+	},
+}
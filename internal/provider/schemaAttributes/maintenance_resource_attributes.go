@@ -2,11 +2,17 @@ package schemaAttributes
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
 )
 
 // MaintenanceResourceAttributes defines the schema for the Maintenance resource
@@ -23,16 +29,16 @@ var MaintenanceResourceAttributes = map[string]schema.Attribute{
 		MarkdownDescription: "The description of the maintenance window",
 	},
 	"start_date": schema.StringAttribute{
-		Required:            true,
-		MarkdownDescription: "The start date/time of the maintenance window in ISO8601 format (e.g., 2023-06-15T10:00:00Z)",
+		Optional:            true,
+		MarkdownDescription: "The start date/time of the maintenance window in ISO8601 format (e.g., 2023-06-15T10:00:00Z). Required unless `recurrence` is set",
 	},
 	"end_date": schema.StringAttribute{
-		Required:            true,
-		MarkdownDescription: "The end date/time of the maintenance window in ISO8601 format (e.g., 2023-06-15T14:00:00Z)",
+		Optional:            true,
+		MarkdownDescription: "The end date/time of the maintenance window in ISO8601 format (e.g., 2023-06-15T14:00:00Z). Required unless `recurrence` is set",
 	},
 	"status": schema.StringAttribute{
 		Computed:            true,
-		MarkdownDescription: "The status of the maintenance window (e.g., scheduled, in_progress, completed, cancelled)",
+		MarkdownDescription: "The status of the maintenance window (e.g., scheduled, in_progress, completed, cancelled). Not set for recurring maintenance windows",
 	},
 	"team_id": schema.StringAttribute{
 		Optional:            true,
@@ -48,6 +54,95 @@ var MaintenanceResourceAttributes = map[string]schema.Attribute{
 				"Force replacement since method value updated"),
 		},
 	},
+	"recurrence": schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "Repeats the maintenance window instead of using `start_date` and `end_date`. The next occurrences are created as maintenance windows and rolled forward on every apply. Recurring maintenance windows can not be imported",
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplaceIf(func(ctx context.Context, request planmodifier.ObjectRequest, response *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+				response.RequiresReplace = request.StateValue.IsNull() != request.PlanValue.IsNull()
+			},
+				"Force replacement when switching between a single and a recurring maintenance window",
+				"Force replacement when switching between a single and a recurring maintenance window"),
+		},
+		Attributes: map[string]schema.Attribute{
+			"frequency": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "How often the maintenance window repeats, either `weekly` or `monthly`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("weekly", "monthly"),
+				},
+			},
+			"days_of_week": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The days of the week the maintenance window starts on (e.g., sunday). Required for weekly recurrences",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(weekdayValidator...),
+				},
+			},
+			"days_of_month": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The days of the month the maintenance window starts on. Required for monthly recurrences. Months without the day are skipped",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueInt64sAre(int64validator.Between(1, 31)),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The time of the day the maintenance window starts, in HH:MM format (e.g., 02:00)",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be a time in HH:MM format"),
+				},
+			},
+			"duration_minutes": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "How long every occurrence of the maintenance window lasts, in minutes",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IANA timezone the days and the start time are interpreted in (e.g., Europe/London)",
+			},
+			"until": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date/time in ISO8601 format after which no occurrence starts. The maintenance window repeats indefinitely if not specified",
+			},
+			"upcoming_occurrences": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(4),
+				MarkdownDescription: "How many upcoming occurrences are kept created as maintenance windows. Defaults to 4",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 20),
+				},
+			},
+		},
+	},
+	"occurrences": schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The maintenance windows created for the upcoming occurrences of a recurring maintenance window, in chronological order. Occurrences that have ended are removed",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The unique identifier of the maintenance window of the occurrence",
+				},
+				"start_date": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The start date/time of the occurrence in ISO8601 format",
+				},
+				"end_date": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The end date/time of the occurrence in ISO8601 format",
+				},
+			},
+		},
+	},
 	"rules": schema.ListNestedAttribute{
 		Required:            true,
 		MarkdownDescription: "A list of rules defining what entities are affected during the maintenance window",
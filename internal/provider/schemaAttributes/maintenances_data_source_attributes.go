@@ -0,0 +1,85 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var MaintenancesDataSourceAttributes = map[string]schema.Attribute{
+	"type": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The maintenance windows to return. One of `all`, `past` or `non-expired`. Defaults to `all`.",
+		Validators: []validator.String{
+			stringvalidator.OneOf("all", "past", "non-expired"),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "If set, only the maintenance windows of this team are returned. Otherwise the global maintenance windows are returned.",
+	},
+	"entity_id": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "If set, only the maintenance windows with a rule affecting this entity (e.g., integration ID, policy ID) are returned.",
+	},
+	"maintenances": schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The maintenance windows matching the filters",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: MaintenanceDataSourceAttributes,
+		},
+	},
+}
+
+var MaintenanceDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The unique identifier of the maintenance window",
+	},
+	"description": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The description of the maintenance window",
+	},
+	"start_date": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The start date/time of the maintenance window in ISO8601 format",
+	},
+	"end_date": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The end date/time of the maintenance window in ISO8601 format",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The status of the maintenance window (e.g., scheduled, in_progress, completed, cancelled)",
+	},
+	"team_id": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The ID of the team associated with this maintenance window",
+	},
+	"rules": schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The rules defining what entities are affected during the maintenance window",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"state": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The state applied to the entity during maintenance (e.g., disabled, enabled, noMaintenance)",
+				},
+				"entity": schema.SingleNestedAttribute{
+					Computed:            true,
+					MarkdownDescription: "The entity affected by this maintenance rule",
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The identifier of the entity (e.g., integration ID, policy ID)",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the entity (e.g., integration, policy, sync)",
+						},
+					},
+				},
+			},
+		},
+	},
+}
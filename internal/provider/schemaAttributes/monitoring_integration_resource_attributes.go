@@ -0,0 +1,193 @@
+package schemaAttributes
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var PrometheusIntegrationResourceAttributes = monitoringIntegrationResourceAttributes(
+	"Prometheus",
+	map[string]schema.Attribute{
+		"close_alert_on_resolve": schema.BoolAttribute{
+			Description: "Whether alerts are closed when Alertmanager reports them as resolved. Defaults to true.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+	},
+	map[string]attr.Value{
+		"close_alert_on_resolve": types.BoolValue(true),
+	},
+)
+
+var DatadogIntegrationResourceAttributes = monitoringIntegrationResourceAttributes(
+	"Datadog",
+	map[string]schema.Attribute{
+		"datadog_site": schema.StringAttribute{
+			Description: "The Datadog site the monitors run on. Valid values are 'US1', 'US3', 'US5', 'EU', 'AP1' and 'US1-FED'. Defaults to 'US1'.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("US1"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("US1", "US3", "US5", "EU", "AP1", "US1-FED"),
+			},
+		},
+		"close_alert_on_recovery": schema.BoolAttribute{
+			Description: "Whether alerts are closed when the Datadog monitor recovers. Defaults to true.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+	},
+	map[string]attr.Value{
+		"datadog_site":            types.StringValue("US1"),
+		"close_alert_on_recovery": types.BoolValue(true),
+	},
+)
+
+var CloudWatchIntegrationResourceAttributes = monitoringIntegrationResourceAttributes(
+	"CloudWatch",
+	map[string]schema.Attribute{
+		"aws_region": schema.StringAttribute{
+			Description: "The AWS region of the CloudWatch alarms (e.g., 'us-east-1'). Used to link alerts back to the alarm in the AWS console.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]{2}(-gov|-iso[a-z]?)?-[a-z]+-\d$`), "must be a valid AWS region"),
+			},
+		},
+		"close_alert_on_ok": schema.BoolAttribute{
+			Description: "Whether alerts are closed when the CloudWatch alarm returns to the OK state. Defaults to true.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+	},
+	map[string]attr.Value{
+		"aws_region":        types.StringNull(),
+		"close_alert_on_ok": types.BoolValue(true),
+	},
+)
+
+var GrafanaIntegrationResourceAttributes = monitoringIntegrationResourceAttributes(
+	"Grafana",
+	map[string]schema.Attribute{
+		"grafana_url": schema.StringAttribute{
+			Description: "The URL of the Grafana instance sending the alerts. Used to link alerts back to Grafana.",
+			Optional:    true,
+			Validators: []validator.String{
+				customValidators.HttpUrl(),
+			},
+		},
+		"close_alert_on_resolve": schema.BoolAttribute{
+			Description: "Whether alerts are closed when Grafana reports them as resolved. Defaults to true.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+	},
+	map[string]attr.Value{
+		"grafana_url":            types.StringNull(),
+		"close_alert_on_resolve": types.BoolValue(true),
+	},
+)
+
+// monitoringIntegrationResourceAttributes returns the schema shared by the monitoring tool integrations, with the
+// given tool specific properties and their default values added to the type specific properties.
+func monitoringIntegrationResourceAttributes(product string, properties map[string]schema.Attribute, defaults map[string]attr.Value) map[string]schema.Attribute {
+	properties["suppress_notifications"] = schema.BoolAttribute{
+		Description: "Whether to suppress notifications for the alerts created by this integration. Defaults to false.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+	defaults["suppress_notifications"] = types.BoolValue(false)
+
+	propertyTypes := make(map[string]attr.Type, len(properties))
+	for name, property := range properties {
+		propertyTypes[name] = property.GetType()
+	}
+
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: fmt.Sprintf("The unique identifier of the %s integration. This is automatically generated when the integration is created.", product),
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: fmt.Sprintf("The name of the %s integration. Must be between 1 and 250 characters.", product),
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 250),
+			},
+		},
+		"api_key": schema.StringAttribute{
+			Description: "The API key of the integration. Only available after the integration is created and cannot be fetched later.",
+			Computed:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"webhook_url": schema.StringAttribute{
+			Description: fmt.Sprintf("The URL to configure in %s to send its alerts to this integration. It contains the API key of the integration, so it is only available when the API key is.", product),
+			Computed:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Description: fmt.Sprintf("Whether the %s integration is enabled. When disabled, incoming alerts are ignored. Defaults to true.", product),
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+		"team_id": schema.StringAttribute{
+			Description: fmt.Sprintf("The ID of the team that owns this %s integration. Used for access control and organization.", product),
+			Optional:    true,
+			Computed:    true,
+		},
+		"advanced": schema.BoolAttribute{
+			Description: fmt.Sprintf("Indicates whether this is an advanced %s integration with additional configuration options.", product),
+			Computed:    true,
+		},
+		"maintenance_sources": schema.ListNestedAttribute{
+			Description: fmt.Sprintf("List of maintenance windows associated with this %s integration. These define when the integration is under maintenance.", product),
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ApiIntegrationResourceMaintenanceSourceAttributes,
+			},
+		},
+		"directions": schema.ListAttribute{
+			Description: "The communication directions supported by this integration (e.g., 'incoming', 'outgoing').",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"domains": schema.ListAttribute{
+			Description: "The domains this integration operates on (e.g., 'alert').",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"type_specific_properties": schema.SingleNestedAttribute{
+			Description: fmt.Sprintf("Configuration properties specific to %s integrations. Defaults are used for any property that is not set.", product),
+			Optional:    true,
+			Computed:    true,
+			Default:     objectdefault.StaticValue(types.ObjectValueMust(propertyTypes, defaults)),
+			Attributes:  properties,
+		},
+	}
+}
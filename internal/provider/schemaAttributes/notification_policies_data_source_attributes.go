@@ -0,0 +1,58 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var NotificationPoliciesDataSourceAttributes = map[string]schema.Attribute{
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team whose notification policies are listed.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"name_regex": schema.StringAttribute{
+		Description: "Only return notification policies whose name matches this regular expression (Go RE2 syntax).",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"notification_policies": schema.ListNestedAttribute{
+		Description: "The notification policies matching the given filters, in the order returned by the API.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: NotificationPolicySummaryDataSourceAttributes,
+		},
+	},
+}
+
+var NotificationPolicySummaryDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the policy.",
+		Computed:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns the policy.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the policy.",
+		Computed:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the policy. Always 'notification'.",
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the policy is enabled.",
+		Computed:    true,
+	},
+	"order": schema.Float64Attribute{
+		Description: "The order of the policy within the policies of its team. Policies are evaluated from the lowest order, which can be fractional.",
+		Computed:    true,
+	},
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -49,9 +50,11 @@ var NotificationPolicyResourceAttributes = map[string]schema.Attribute{
 		Description: "Whether the notification policy is enabled",
 	},
 	"order": schema.Float64Attribute{
-		Optional:    true,
 		Computed:    true,
-		Description: "Order of the notification policy",
+		Description: "Order of the notification policy. The order is managed with the `atlassian-operations_notification_policy_order` resource.",
+		PlanModifiers: []planmodifier.Float64{
+			float64planmodifier.UseStateForUnknown(),
+		},
 	},
 	"filter": schema.SingleNestedAttribute{
 		Optional:    true,
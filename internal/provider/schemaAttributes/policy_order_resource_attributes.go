@@ -0,0 +1,63 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var AlertPolicyOrderResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the alert policy order. It is the team ID, or `global` for the global alert policies.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The unique identifier of the team whose alert policies are ordered. The global alert policies are ordered if not specified.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"policy_ids": policyIdsAttribute("alert"),
+}
+
+var NotificationPolicyOrderResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the notification policy order. It is the same as the team ID.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The unique identifier of the team whose notification policies are ordered.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"policy_ids": policyIdsAttribute("notification"),
+}
+
+func policyIdsAttribute(policyType string) schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "The IDs of the " + policyType + " policies in the order they should be evaluated. The listed policies are moved to the top, the policies that are not listed keep their relative order after them.",
+		Required:    true,
+		ElementType: types.StringType,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+	}
+}
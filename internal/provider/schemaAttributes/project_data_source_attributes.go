@@ -0,0 +1,37 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var ProjectDataSourceAttributes = map[string]schema.Attribute{
+	"key": schema.StringAttribute{
+		Description: "The key of the project (e.g., 'OPS').",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"id": schema.StringAttribute{
+		Description: "The unique identifier for the project. This is the ID expected by the projects of a service.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the project.",
+		Computed:    true,
+	},
+	"description": schema.StringAttribute{
+		Description: "The description of the project.",
+		Computed:    true,
+	},
+	"project_type_key": schema.StringAttribute{
+		Description: "The type of the project (e.g., 'software', 'service_desk', 'business').",
+		Computed:    true,
+	},
+	"self": schema.StringAttribute{
+		Description: "The URL to the REST API endpoint for this project.",
+		Computed:    true,
+	},
+}
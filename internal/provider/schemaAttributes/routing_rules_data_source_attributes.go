@@ -0,0 +1,114 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var RoutingRulesDataSourceAttributes = map[string]schema.Attribute{
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team whose routing rules are listed.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"routing_rules": schema.ListNestedAttribute{
+		Description: "The routing rules of the team, in evaluation order. The default rule of the team is flagged with is_default.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: RoutingRuleDataSourceAttributes,
+		},
+	},
+}
+
+var RoutingRuleDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the routing rule.",
+		Computed:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The unique identifier of the team that owns this routing rule.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the routing rule.",
+		Computed:    true,
+	},
+	"order": schema.Int64Attribute{
+		Description: "The index of the routing rule within the rules of the team, starting from 0.",
+		Computed:    true,
+	},
+	"is_default": schema.BoolAttribute{
+		Description: "Indicates whether this is the default routing rule of the team. Default rules are used when no other rules match.",
+		Computed:    true,
+	},
+	"timezone": schema.StringAttribute{
+		Description: "The timezone used for time-based routing decisions, as an IANA timezone identifier.",
+		Computed:    true,
+	},
+	"criteria": schema.SingleNestedAttribute{
+		Description: "The conditions that determine when this routing rule is applied to an incident.",
+		Computed:    true,
+		Attributes:  CriteriaDataSourceAttributes,
+	},
+	"time_restriction": schema.SingleNestedAttribute{
+		Description: "Time-based restrictions for when this routing rule is active.",
+		Computed:    true,
+		Attributes:  TimeRestrictionDataSourceAttributes,
+	},
+	"notify": schema.SingleNestedAttribute{
+		Description: "How incidents matching this rule are handled.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "The type of notification. One of 'none', 'escalation' or 'schedule'.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the escalation policy or schedule that is notified.",
+				Computed:    true,
+			},
+		},
+	},
+}
+
+var CriteriaDataSourceAttributes = map[string]schema.Attribute{
+	"type": schema.StringAttribute{
+		Description: "The type of criteria matching. One of 'match-all', 'match-all-conditions' or 'match-any-condition'.",
+		Computed:    true,
+	},
+	"conditions": schema.ListNestedAttribute{
+		Description: "The conditions evaluated against the incident.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"field": schema.StringAttribute{
+					Description: "The incident field that is evaluated.",
+					Computed:    true,
+				},
+				"operation": schema.StringAttribute{
+					Description: "The comparison operation performed on the field.",
+					Computed:    true,
+				},
+				"expected_value": schema.StringAttribute{
+					Description: "The value the field is compared against.",
+					Computed:    true,
+				},
+				"key": schema.StringAttribute{
+					Description: "The key of the key-value pair evaluated when field is 'extra-properties'.",
+					Computed:    true,
+				},
+				"not": schema.BoolAttribute{
+					Description: "Indicates whether the result of the operation is negated.",
+					Computed:    true,
+				},
+				"order": schema.Int64Attribute{
+					Description: "The order of the condition in the conditions list.",
+					Computed:    true,
+				},
+			},
+		},
+	},
+}
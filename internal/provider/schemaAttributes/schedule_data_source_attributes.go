@@ -1,15 +1,31 @@
 package schemaAttributes
 
-import "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
 
 var ScheduleDataSourceAttributes = map[string]schema.Attribute{
 	"id": schema.StringAttribute{
-		Description: "The unique identifier of the schedule. This is automatically generated when the schedule is created.",
+		Description: "The unique identifier of the schedule. Can be used instead of name and team_id to look up the schedule directly.",
+		Optional:    true,
 		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.AtLeastOneOf(path.MatchRoot("name"), path.MatchRoot("team_id")),
+			stringvalidator.ConflictsWith(path.MatchRoot("name"), path.MatchRoot("team_id")),
+		},
 	},
 	"name": schema.StringAttribute{
-		Description: "The name of the schedule. This is used to look up the schedule and must be unique within your organization.",
-		Required:    true,
+		Description: "The name of the schedule. Only schedules whose name matches exactly are returned; combine with team_id when the name is not unique within your organization.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"ignore_case": schema.BoolAttribute{
+		Description: "Set to true to match the name case-insensitively. Defaults to false.",
+		Optional:    true,
 	},
 	"description": schema.StringAttribute{
 		Description: "A detailed description of the schedule's purpose and coverage. This helps team members understand the schedule's role.",
@@ -24,7 +40,71 @@ var ScheduleDataSourceAttributes = map[string]schema.Attribute{
 		Computed:    true,
 	},
 	"team_id": schema.StringAttribute{
-		Description: "The unique identifier of the team that owns this schedule. Used for access control and organization.",
+		Description: "The unique identifier of the team that owns this schedule. Can be used to look up a team's schedule or to disambiguate schedules sharing a name.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"rotations": schema.ListNestedAttribute{
+		Description: "The rotations defined in this schedule.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: RotationDataSourceAttributes,
+		},
+	},
+}
+
+var RotationDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the rotation.",
+		Computed:    true,
+	},
+	"schedule_id": schema.StringAttribute{
+		Description: "The ID of the schedule this rotation belongs to.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the rotation.",
+		Computed:    true,
+	},
+	"start_date": schema.StringAttribute{
+		Description: "The date and time when this rotation begins, in RFC3339 format.",
+		Computed:    true,
+		CustomType:  timetypes.RFC3339Type{},
+	},
+	"end_date": schema.StringAttribute{
+		Description: "The date and time when this rotation ends, in RFC3339 format. Null if the rotation continues indefinitely.",
+		Computed:    true,
+		CustomType:  timetypes.RFC3339Type{},
+	},
+	"type": schema.StringAttribute{
+		Description: "The frequency of rotation. One of 'daily', 'weekly' or 'hourly'.",
+		Computed:    true,
+	},
+	"length": schema.Int32Attribute{
+		Description: "The duration of each rotation shift in units matching the rotation type.",
+		Computed:    true,
+	},
+	"participants": schema.ListNestedAttribute{
+		Description: "The list of participants in this rotation, in rotation order.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ResponderInfoDataSourceAttributes,
+		},
+	},
+	"time_restriction": schema.SingleNestedAttribute{
+		Description: "Time restrictions for when this rotation is active.",
+		Computed:    true,
+		Attributes:  TimeRestrictionDataSourceAttributes,
+	},
+}
+
+var ResponderInfoDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the participant (user ID, team ID, or escalation policy ID). Null when type is 'noone'.",
+		Computed:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of participant. One of 'user', 'team', 'escalation' or 'noone'.",
 		Computed:    true,
 	},
 }
@@ -0,0 +1,80 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ScheduleOverrideResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The identifier of the override. This is the same as its alias.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"schedule_id": schema.StringAttribute{
+		Description: "The ID of the schedule the override belongs to.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"alias": schema.StringAttribute{
+		Description: "A user defined identifier of the override, unique within the schedule. Generated by the server if not specified.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"responder": schema.SingleNestedAttribute{
+		Description: "The user or team that is on call instead of the original participants while the override is active.",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user or team.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the responder. Valid values are 'user' and 'team'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "team"),
+				},
+			},
+		},
+	},
+	"start_date": schema.StringAttribute{
+		Description: "The date and time when the override begins, in RFC3339 format (e.g., '2024-01-01T00:00:00Z').",
+		Required:    true,
+		CustomType:  timetypes.RFC3339Type{},
+	},
+	"end_date": schema.StringAttribute{
+		Description: "The date and time when the override ends, in RFC3339 format. The override is removed from the state once this date has passed.",
+		Required:    true,
+		CustomType:  timetypes.RFC3339Type{},
+	},
+	"rotation_ids": schema.SetAttribute{
+		Description: "The IDs of the rotations the override applies to. If empty, the override applies to all rotations of the schedule.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+	},
+}
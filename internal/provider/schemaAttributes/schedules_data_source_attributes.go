@@ -0,0 +1,59 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var SchedulesDataSourceAttributes = map[string]schema.Attribute{
+	"team_id": schema.StringAttribute{
+		Description: "Only return schedules owned by the team with this ID.",
+		Optional:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Only return schedules that are enabled (true) or disabled (false).",
+		Optional:    true,
+	},
+	"name_regex": schema.StringAttribute{
+		Description: "Only return schedules whose name matches this regular expression (Go RE2 syntax).",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"schedules": schema.ListNestedAttribute{
+		Description: "The schedules matching the given filters, in the order returned by the API.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ScheduleSummaryDataSourceAttributes,
+		},
+	},
+}
+
+var ScheduleSummaryDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the schedule.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the schedule.",
+		Computed:    true,
+	},
+	"description": schema.StringAttribute{
+		Description: "A detailed description of the schedule's purpose and coverage.",
+		Computed:    true,
+	},
+	"timezone": schema.StringAttribute{
+		Description: "The timezone in IANA format (e.g., 'America/New_York') that this schedule operates in.",
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Indicates whether the schedule is currently active.",
+		Computed:    true,
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The unique identifier of the team that owns this schedule.",
+		Computed:    true,
+	},
+}
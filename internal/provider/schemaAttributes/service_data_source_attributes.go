@@ -0,0 +1,90 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ServiceDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The ID of the JSM service. Either id or name must be set.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the JSM service. Only the service whose name matches exactly is returned.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"description": schema.StringAttribute{
+		Description: "The description of the JSM service",
+		Computed:    true,
+	},
+	"tier": schema.Int32Attribute{
+		Description: "The tier level of the JSM service",
+		Computed:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the JSM service",
+		Computed:    true,
+	},
+	"owner": schema.StringAttribute{
+		Description: "The owner team ID of the JSM service",
+		Computed:    true,
+	},
+	"change_approvers": schema.SingleNestedAttribute{
+		Description: "Change approvers configuration for the JSM service",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"groups": schema.ListAttribute{
+				Description: "List of group IDs for change approvers",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	},
+	"responders": schema.SingleNestedAttribute{
+		Description: "Responders configuration for the JSM service",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"users": schema.ListAttribute{
+				Description: "List of user IDs for responders",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"teams": schema.ListAttribute{
+				Description: "List of team IDs for responders",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	},
+	"stakeholders": schema.SingleNestedAttribute{
+		Description: "Stakeholders configuration for the JSM service",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"users": schema.ListAttribute{
+				Description: "List of user IDs for stakeholders",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	},
+	"projects": schema.SingleNestedAttribute{
+		Description: "Projects configuration for the JSM service",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				Description: "List of project IDs",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	},
+}
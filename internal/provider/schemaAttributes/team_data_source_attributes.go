@@ -53,6 +53,24 @@ var TeamDataSourceAttributes = map[string]schema.Attribute{
 		Required:    false,
 		Computed:    true,
 	},
+	"manage_members": schema.BoolAttribute{
+		Description: "Whether the members of the team are managed by the team resource. Always true for the data source.",
+		Optional:    false,
+		Required:    false,
+		Computed:    true,
+	},
+	"default_schedule_id": schema.StringAttribute{
+		Description: "The ID of the first schedule of the team, which is the one created together with the team unless it was deleted.",
+		Computed:    true,
+	},
+	"default_escalation_id": schema.StringAttribute{
+		Description: "The ID of the first escalation of the team, which is the one created together with the team unless it was deleted.",
+		Computed:    true,
+	},
+	"default_routing_rule_id": schema.StringAttribute{
+		Description: "The ID of the default routing rule of the team.",
+		Computed:    true,
+	},
 }
 
 var PublicApiUserPermissionsDataSourceAttributes = map[string]schema.Attribute{
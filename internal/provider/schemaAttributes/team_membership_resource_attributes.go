@@ -0,0 +1,50 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var TeamMembershipResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The identifier of the membership, in the format team_id,account_id,organization_id, which can be used to import it.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"organization_id": schema.StringAttribute{
+		Description: "The unique identifier of the organization the team belongs to.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team. The team should set `manage_members` to false, so that it does not remove this member.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"account_id": schema.StringAttribute{
+		Description: "The Atlassian account ID of the member.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"role": schema.StringAttribute{
+		Description: "The role of the member in the operations team. Valid values are 'admin', 'user' or the ID of a custom role. Defaults to 'user'.",
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString("user"),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+}
@@ -0,0 +1,44 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var TeamOpsEnablementResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The identifier of the enablement. This is the same as the team ID.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"organization_id": schema.StringAttribute{
+		Description: "The unique identifier of the organization the team belongs to.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of an existing Atlassian platform team to enable operations for.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"admin_account_ids": schema.SetAttribute{
+		Description: "The account IDs of the users who become admins of the operations team. Only used when operations are enabled, so it can not be changed afterwards. Use `atlassian-operations_team_member` to manage roles afterwards.",
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+	},
+}
@@ -1,14 +1,17 @@
 package schemaAttributes
 
 import (
+	"context"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var TeamResourceAttributes = map[string]schema.Attribute{
@@ -53,8 +56,12 @@ var TeamResourceAttributes = map[string]schema.Attribute{
 		Attributes:  PublicApiUserPermissionsResourceAttributes,
 	},
 	"member": schema.SetNestedAttribute{
-		Description: "The set of users who are members of this team. Must contain at least one member. Each member is identified by their Atlassian account ID.",
-		Required:    true,
+		Description: "The set of users who are members of this team. Must contain at least one member. Each member is identified by their Atlassian account ID. Required when `manage_members` is true, and must not be set otherwise.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: TeamMemberResourceAttributes,
 		},
@@ -62,6 +69,24 @@ var TeamResourceAttributes = map[string]schema.Attribute{
 			setvalidator.SizeAtLeast(1),
 		},
 	},
+	"admin_account_ids": schema.SetAttribute{
+		Description: "The account IDs of the members who become the admins of the team when operations are enabled for it on creation. Each of them must be a member of the team. Defaults to the user the provider authenticates as, who must then be a member: creating a team without admin_account_ids fails otherwise, which is a breaking change from earlier versions that picked an arbitrary member. Changing it forces a new team, since the admins can not be changed through enabling operations again.",
+		Optional:    true,
+		Computed:    true,
+		ElementType: types.StringType,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+			setplanmodifier.RequiresReplaceIf(func(ctx context.Context, request planmodifier.SetRequest, response *setplanmodifier.RequiresReplaceIfFuncResponse) {
+				// Imported teams have no admins in the state, so the configured ones are taken over instead
+				response.RequiresReplace = !request.StateValue.IsNull()
+			},
+				"Force replacement since the admins are only set when the team is created",
+				"Force replacement since the admins are only set when the team is created"),
+		},
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	},
 	"delete_default_resources": schema.BoolAttribute{
 		Description: "Set to true to remove default escalation and schedule for newly created team. Be careful its also changes that team routing rule to None. That means you have to define routing rule as well. Defaults to false.",
 		Optional:    true,
@@ -69,6 +94,33 @@ var TeamResourceAttributes = map[string]schema.Attribute{
 		Computed:    true,
 		Default:     booldefault.StaticBool(false),
 	},
+	"manage_members": schema.BoolAttribute{
+		Description: "Set to false to manage the members of the team outside of this resource, for example with `atlassian-operations_team_member` resources. The members are then only read into `member`. Defaults to true.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	},
+	"default_schedule_id": schema.StringAttribute{
+		Description: "The ID of the schedule created together with the team. Null if it was removed with `delete_default_resources`.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"default_escalation_id": schema.StringAttribute{
+		Description: "The ID of the escalation created together with the team. Null if it was removed with `delete_default_resources`.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"default_routing_rule_id": schema.StringAttribute{
+		Description: "The ID of the default routing rule of the team. It can be configured with `atlassian-operations_team_default_routing_rule`.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
 }
 
 var PublicApiUserPermissionsResourceAttributes = map[string]schema.Attribute{
@@ -0,0 +1,68 @@
+package schemaAttributes
+
+import "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+var TimeRestrictionDataSourceAttributes = map[string]schema.Attribute{
+	"type": schema.StringAttribute{
+		Description: "The type of time restriction. Either 'time-of-day' for daily recurring windows or 'weekday-and-time-of-day' for weekly schedules.",
+		Computed:    true,
+	},
+	"restriction": schema.SingleNestedAttribute{
+		Description: "Configuration for daily time windows. Set when type is 'time-of-day'.",
+		Computed:    true,
+		Attributes:  TimeOfDayTimeRestrictionDataSourceAttributes,
+	},
+	"restrictions": schema.ListNestedAttribute{
+		Description: "List of weekly time windows. Set when type is 'weekday-and-time-of-day'.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: WeekdayTimeRestrictionDataSourceAttributes,
+		},
+	},
+}
+
+var TimeOfDayTimeRestrictionDataSourceAttributes = map[string]schema.Attribute{
+	"start_hour": schema.Int32Attribute{
+		Description: "The hour when the restriction begins (0-23, where 0 is midnight).",
+		Computed:    true,
+	},
+	"end_hour": schema.Int32Attribute{
+		Description: "The hour when the restriction ends (0-23, where 0 is midnight).",
+		Computed:    true,
+	},
+	"start_min": schema.Int32Attribute{
+		Description: "The minute when the restriction begins.",
+		Computed:    true,
+	},
+	"end_min": schema.Int32Attribute{
+		Description: "The minute when the restriction ends.",
+		Computed:    true,
+	},
+}
+
+var WeekdayTimeRestrictionDataSourceAttributes = map[string]schema.Attribute{
+	"start_day": schema.StringAttribute{
+		Description: "The day of the week when the restriction begins (e.g., 'monday').",
+		Computed:    true,
+	},
+	"end_day": schema.StringAttribute{
+		Description: "The day of the week when the restriction ends (e.g., 'friday').",
+		Computed:    true,
+	},
+	"start_hour": schema.Int32Attribute{
+		Description: "The hour when the restriction begins on the start day (0-23, where 0 is midnight).",
+		Computed:    true,
+	},
+	"end_hour": schema.Int32Attribute{
+		Description: "The hour when the restriction ends on the end day (0-23, where 0 is midnight).",
+		Computed:    true,
+	},
+	"start_min": schema.Int32Attribute{
+		Description: "The minute when the restriction begins on the start day.",
+		Computed:    true,
+	},
+	"end_min": schema.Int32Attribute{
+		Description: "The minute when the restriction ends on the end day.",
+		Computed:    true,
+	},
+}
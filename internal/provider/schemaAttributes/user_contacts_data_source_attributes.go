@@ -0,0 +1,47 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var UserContactsDataSourceAttributes = map[string]schema.Attribute{
+	"method": schema.StringAttribute{
+		Description: "Only return contacts using this method. Valid values are 'email', 'sms', 'voice', or 'mobile'.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("email", "sms", "voice", "mobile"),
+		},
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Only return contacts that are enabled (true) or disabled (false).",
+		Optional:    true,
+	},
+	"contacts": schema.ListNestedAttribute{
+		Description: "The contacts of the user the provider is authenticated as.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: UserContactDataSourceAttributes,
+		},
+	},
+}
+
+var UserContactDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the contact.",
+		Computed:    true,
+	},
+	"method": schema.StringAttribute{
+		Description: "The method of contact. One of 'email', 'sms', 'voice', or 'mobile'.",
+		Computed:    true,
+	},
+	"to": schema.StringAttribute{
+		Description: "The destination of the contact, such as an email address or phone number.",
+		Computed:    true,
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether this contact method is enabled for the user.",
+		Computed:    true,
+	},
+}
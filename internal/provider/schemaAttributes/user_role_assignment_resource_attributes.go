@@ -0,0 +1,40 @@
+package schemaAttributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var UserRoleAssignmentResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The identifier of the role assignment. It is the same as the account ID.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"account_id": schema.StringAttribute{
+		Description: "The Atlassian account ID of the user the role is assigned to.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	},
+	"role": schema.StringAttribute{
+		Description: "The role assigned to the user. Valid values are 'admin', 'user', 'stakeholder' or the ID of a custom role.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"previous_role": schema.StringAttribute{
+		Description: "The role the user had before the assignment, which is given back when the resource is destroyed. Null for imported assignments, whose users get the 'user' role back instead.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+}
@@ -0,0 +1,109 @@
+package schemaAttributes
+
+import (
+	"regexp"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes/customValidators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var WebhookIntegrationResourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The unique identifier of the webhook integration. This is automatically generated when the integration is created.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the webhook integration. Must be between 1 and 250 characters.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 250),
+		},
+	},
+	"enabled": schema.BoolAttribute{
+		Description: "Whether the webhook integration is enabled. When disabled, no requests are sent to the webhook. Defaults to true.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	},
+	"team_id": schema.StringAttribute{
+		Description: "The ID of the team that owns this webhook integration. Used for access control and organization.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"advanced": schema.BoolAttribute{
+		Description: "Indicates whether this is an advanced webhook integration with additional configuration options.",
+		Computed:    true,
+	},
+	"maintenance_sources": schema.ListNestedAttribute{
+		Description: "List of maintenance windows associated with this webhook integration. These define when the integration is under maintenance.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ApiIntegrationResourceMaintenanceSourceAttributes,
+		},
+	},
+	"directions": schema.ListAttribute{
+		Description: "The communication directions supported by this integration (e.g., 'incoming', 'outgoing').",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"domains": schema.ListAttribute{
+		Description: "The domains this integration operates on (e.g., 'alert').",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"type_specific_properties": schema.SingleNestedAttribute{
+		Description: "Configuration properties specific to webhook integrations, such as the target URL, request headers and payload options.",
+		Attributes:  WebhookIntegrationTypeSpecificResourceAttributes,
+		Required:    true,
+	},
+}
+
+var WebhookIntegrationTypeSpecificResourceAttributes = map[string]schema.Attribute{
+	"url": schema.StringAttribute{
+		Description: "The absolute http or https URL the alert payloads are posted to.",
+		Required:    true,
+		Validators: []validator.String{
+			customValidators.HttpUrl(),
+		},
+	},
+	"headers": schema.MapAttribute{
+		Description: "Custom headers sent with each request, keyed by header name. Header names must be valid HTTP tokens and values must not contain line breaks. Defaults to no headers.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Sensitive:   true,
+		Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+		Validators: []validator.Map{
+			mapvalidator.KeysAre(
+				stringvalidator.RegexMatches(regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$"), "must be a valid HTTP header name"),
+			),
+			mapvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[^\r\n]*$`), "must not contain line breaks"),
+			),
+		},
+	},
+	"add_alert_description": schema.BoolAttribute{
+		Description: "Whether to include the alert description in the payload. Defaults to true.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	},
+	"add_alert_details": schema.BoolAttribute{
+		Description: "Whether to include the alert details (extra properties) in the payload. Defaults to true.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	},
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ServiceDataSource{}
+	_ datasource.DataSourceWithConfigure = &ServiceDataSource{}
+)
+
+func NewServiceDataSource() datasource.DataSource {
+	return &ServiceDataSource{}
+}
+
+// ServiceDataSource defines the data source implementation.
+type ServiceDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *ServiceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (d *ServiceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Service data source",
+		Attributes:          schemaAttributes.ServiceDataSourceAttributes,
+	}
+}
+
+func (d *ServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring service_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure service_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured service_data_source")
+}
+
+func (d *ServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataModels.ServiceModel
+	var service dto.ServiceDto
+
+	tflog.Trace(ctx, "Reading service data source from JSM API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read service configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	if !data.ID.IsNull() {
+		service = d.readServiceById(ctx, data.ID.ValueString(), &resp.Diagnostics)
+	} else {
+		service = d.findServiceByName(ctx, data.Name.ValueString(), &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "HTTP request to JSM API Succeeded. Parsing the fetched data to Terraform model")
+	modelPtr, diags := ServiceDtoToModel(ctx, &service)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	data = *modelPtr
+
+	tflog.Trace(ctx, "Successfully read service data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ServiceDataSource) readServiceById(ctx context.Context, serviceId string, diagnostics *diag.Diagnostics) dto.ServiceDto {
+	var service dto.ServiceDto
+
+	tflog.Trace(ctx, "Sending HTTP request to JSM API")
+
+	httpResp, err := httpClientHelpers.
+		GenerateServiceClientRequest(d.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/services/%s", serviceId)).
+		Method(httpClient.GET).
+		SetBodyParseObject(&service).
+		Send()
+
+	handleHttpResponse(httpResp, err, "read JSM service", diagnostics, ctx)
+	return service
+}
+
+// findServiceByName pages through the services of the site and returns the single service whose name matches exactly.
+func (d *ServiceDataSource) findServiceByName(ctx context.Context, name string, diagnostics *diag.Diagnostics) dto.ServiceDto {
+	services := listServices(ctx, d.clientConfiguration, diagnostics)
+	if diagnostics.HasError() {
+		return dto.ServiceDto{}
+	}
+
+	matches := make([]dto.ServiceDto, 0)
+	for _, service := range services {
+		if service.Name == name {
+			matches = append(matches, service)
+		}
+	}
+
+	if len(matches) == 0 {
+		tflog.Error(ctx, "No JSM services found")
+		diagnostics.AddError("Client Error", fmt.Sprintf("No JSM service named %q found", name))
+		return dto.ServiceDto{}
+	} else if len(matches) > 1 {
+		ids := make([]string, len(matches))
+		for i, service := range matches {
+			ids[i] = service.ID
+		}
+		tflog.Error(ctx, fmt.Sprintf("Multiple JSM services found: %s", strings.Join(ids, ", ")))
+		diagnostics.AddError("Client Error",
+			fmt.Sprintf("Multiple JSM services named %q found (%s). Please use the id attribute to select one of them.", name, strings.Join(ids, ", ")))
+		return dto.ServiceDto{}
+	}
+
+	return matches[0]
+}
+
+// listServices returns every JSM service of the site.
+func listServices(ctx context.Context, configuration dto.AtlassianOpsProviderModel, diagnostics *diag.Diagnostics) []dto.ServiceDto {
+	return listAllPages[dto.ServiceDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateServiceClientRequest(configuration)
+		},
+		"/v1/services",
+		map[string]string{},
+		"list JSM services",
+		diagnostics,
+	)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ServicesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ServicesDataSource{}
+)
+
+func NewServicesDataSource() datasource.DataSource {
+	return &ServicesDataSource{}
+}
+
+// ServicesDataSource defines the data source implementation.
+type ServicesDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *ServicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_services"
+}
+
+func (d *ServicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Services data source",
+		Attributes:          schemaAttributes.ServicesDataSourceAttributes,
+	}
+}
+
+func (d *ServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring services_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure services_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured services_data_source")
+}
+
+func (d *ServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataModels.ServicesDataSourceModel
+
+	tflog.Trace(ctx, "Reading services data source from JSM API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read services configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM API")
+
+	services := listServices(ctx, d.clientConfiguration, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceValues := make([]attr.Value, 0)
+	for _, service := range services {
+		if !data.Tier.IsNull() && service.Tier != data.Tier.ValueInt32() {
+			continue
+		}
+		if !data.Type.IsNull() && service.Type != data.Type.ValueString() {
+			continue
+		}
+		if !data.Owner.IsNull() && service.Owner != data.Owner.ValueString() {
+			continue
+		}
+
+		modelPtr, diags := ServiceDtoToModel(ctx, &service)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		serviceValues = append(serviceValues, modelPtr.AsValue())
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("HTTP requests to JSM API Succeeded. Found %d services", len(serviceValues)))
+	data.Services = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.ServiceModelMap}, serviceValues)
+
+	tflog.Trace(ctx, "Successfully read services data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
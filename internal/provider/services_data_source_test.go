@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServicesDataSource(t *testing.T) {
+	teamName := uuid.NewString()
+	serviceName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_service" "example" {
+  name        = "` + serviceName + `"
+  description = "Test JSM Service Description"
+  tier        = 3
+  type        = "SOFTWARE_SERVICES"
+  owner       = atlassian-operations_team.example.id
+  responders = {
+    teams = [
+      atlassian-operations_team.example.id
+    ]
+  }
+}
+
+resource "atlassian-operations_service" "business" {
+  name        = "` + serviceName + `-business"
+  description = "Test JSM Service Description"
+  tier        = 1
+  type        = "BUSINESS_SERVICES"
+  owner       = atlassian-operations_team.example.id
+}
+
+data "atlassian-operations_services" "test" {
+	depends_on = ["atlassian-operations_service.example", "atlassian-operations_service.business"]
+	owner = atlassian-operations_team.example.id
+}
+
+data "atlassian-operations_services" "test_filtered" {
+	depends_on = ["atlassian-operations_service.example", "atlassian-operations_service.business"]
+	owner = atlassian-operations_team.example.id
+	tier  = 3
+	type  = "SOFTWARE_SERVICES"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the data source
+					resource.TestCheckResourceAttr("data.atlassian-operations_services.test", "services.#", "2"),
+					// Verify the filters
+					resource.TestCheckResourceAttr("data.atlassian-operations_services.test_filtered", "services.#", "1"),
+					resource.TestCheckResourceAttrPair("data.atlassian-operations_services.test_filtered", "services.0.id", "atlassian-operations_service.example", "id"),
+					resource.TestCheckResourceAttr("data.atlassian-operations_services.test_filtered", "services.0.name", serviceName),
+					resource.TestCheckResourceAttr("data.atlassian-operations_services.test_filtered", "services.0.tier", "3"),
+				),
+			},
+		},
+	})
+}
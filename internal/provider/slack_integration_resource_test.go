@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackIntegrationResource(t *testing.T) {
+	integrationName := uuid.NewString()
+	integrationUpdateName := uuid.NewString()
+
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamConfig := `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + teamConfig + `
+resource "atlassian-operations_slack_integration" "example" {
+  name    = "` + integrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type_specific_properties = {
+    workspace     = "T0123ABCD"
+    channel       = "#alerts"
+    alert_actions = ["Create", "Acknowledge", "Close"]
+    filter = {
+      type = "match-any-condition"
+      conditions = [
+        {
+          field          = "priority"
+          operation      = "equals"
+          expected_value = "P1"
+        }
+      ]
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "name", integrationName),
+					resource.TestCheckResourceAttrPair("atlassian-operations_slack_integration.example", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "enabled", "true"),
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "type_specific_properties.workspace", "T0123ABCD"),
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "type_specific_properties.channel", "#alerts"),
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "type_specific_properties.alert_actions.#", "3"),
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "type_specific_properties.filter.type", "match-any-condition"),
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "type_specific_properties.filter.conditions.0.expected_value", "P1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "atlassian-operations_slack_integration.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"directions.#", "domains.#", "domains.0", "directions.0"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + teamConfig + `
+resource "atlassian-operations_slack_integration" "example" {
+  name    = "` + integrationUpdateName + `"
+  team_id = atlassian-operations_team.example.id
+  enabled = false
+  type_specific_properties = {
+    workspace     = "T0123ABCD"
+    channel       = "#alerts-updated"
+    alert_actions = ["Create"]
+    filter = {
+      type = "match-all"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "name", integrationUpdateName),
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "enabled", "false"),
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "type_specific_properties.channel", "#alerts-updated"),
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "type_specific_properties.alert_actions.#", "1"),
+					resource.TestCheckResourceAttr("atlassian-operations_slack_integration.example", "type_specific_properties.filter.type", "match-all"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -68,7 +67,7 @@ func (d *teamDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var model dataModels.TeamModel
+	var model dataModels.TeamDataSourceModel
 	var data dto.TeamDto
 	var memberData dto.TeamMemberListResponse
 
@@ -146,7 +145,8 @@ func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	tflog.Trace(ctx, "Converting Team Data into Terraform Model")
 	// Convert the fetched data into the model
-	model = TeamDtoToModel(data, memberData.Results, basetypes.NewBoolValue(false))
+	model = TeamDataSourceDtoToModel(data, memberData.Results)
+	model.DefaultScheduleId, model.DefaultEscalationId, model.DefaultRoutingRuleId = findTeamDefaults(ctx, d.clientConfiguration, data.TeamId, &resp.Diagnostics)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
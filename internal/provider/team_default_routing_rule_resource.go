@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &TeamDefaultRoutingRuleResource{}
+	_ resource.ResourceWithConfigure   = &TeamDefaultRoutingRuleResource{}
+	_ resource.ResourceWithImportState = &TeamDefaultRoutingRuleResource{}
+)
+
+// TeamDefaultRoutingRuleResource configures the default routing rule that every operations team has
+type TeamDefaultRoutingRuleResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewTeamDefaultRoutingRuleResource() resource.Resource {
+	return &TeamDefaultRoutingRuleResource{}
+}
+
+// Metadata returns metadata for the resource
+func (r *TeamDefaultRoutingRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_default_routing_rule"
+}
+
+// Schema defines the schema for the resource
+func (r *TeamDefaultRoutingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configure the default routing rule of a team in place. The rule can not be deleted, so destroying this resource only removes it from the state.",
+		Attributes:  schemaAttributes.TeamDefaultRoutingRuleResourceAttributes,
+	}
+}
+
+// Configure sets up the resource with provider configuration
+func (r *TeamDefaultRoutingRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring TeamDefaultRoutingRuleResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dto.JsmopsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured TeamDefaultRoutingRuleResource")
+}
+
+// Create takes over the default routing rule of the team and updates it
+func (r *TeamDefaultRoutingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating TeamDefaultRoutingRuleResource")
+
+	var plan dataModels.TeamDefaultRoutingRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultRule := r.findDefaultRoutingRule(ctx, plan.TeamID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleDto := TeamDefaultRoutingRuleModelToDto(ctx, plan)
+	ruleDto.ID = defaultRule.ID
+	if plan.Name.IsUnknown() {
+		ruleDto.Name = defaultRule.Name
+	}
+
+	r.updateRoutingRule(ctx, plan.TeamID.ValueString(), &ruleDto, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := TeamDefaultRoutingRuleDtoToModel(plan.TeamID.ValueString(), ruleDto)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "Created TeamDefaultRoutingRuleResource")
+}
+
+// Read handles the read operation for the resource
+func (r *TeamDefaultRoutingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataModels.TeamDefaultRoutingRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Reading TeamDefaultRoutingRuleResource")
+
+	// The rule is looked up by its flag, so that imports only need the team and a replaced default is noticed
+	defaultRule := r.findDefaultRoutingRule(ctx, state.TeamID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := TeamDefaultRoutingRuleDtoToModel(state.TeamID.ValueString(), *defaultRule)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update handles the update operation for the resource
+func (r *TeamDefaultRoutingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataModels.TeamDefaultRoutingRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating TeamDefaultRoutingRuleResource")
+
+	ruleDto := TeamDefaultRoutingRuleModelToDto(ctx, plan)
+
+	r.updateRoutingRule(ctx, plan.TeamID.ValueString(), &ruleDto, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := TeamDefaultRoutingRuleDtoToModel(plan.TeamID.ValueString(), ruleDto)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "Updated TeamDefaultRoutingRuleResource")
+}
+
+// Delete only removes the resource from the state, since a team always has a default routing rule
+func (r *TeamDefaultRoutingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataModels.TeamDefaultRoutingRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Removed TeamDefaultRoutingRuleResource from the state, the routing rule is kept", map[string]interface{}{"teamId": state.TeamID.ValueString()})
+}
+
+// ImportState imports the default routing rule of a team by the team ID
+func (r *TeamDefaultRoutingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("team_id"), req, resp)
+}
+
+func (r *TeamDefaultRoutingRuleResource) findDefaultRoutingRule(ctx context.Context, teamId string, diagnostics *diag.Diagnostics) *dto.RoutingRuleDto {
+	routingRules := listAllPages[dto.RoutingRuleDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(r.clientConfiguration)
+		},
+		fmt.Sprintf("/v1/teams/%s/routing-rules", teamId),
+		map[string]string{},
+		"list routing rules",
+		diagnostics,
+	)
+
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	for _, rule := range routingRules {
+		if rule.IsDefault {
+			return &rule
+		}
+	}
+
+	tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to find the default routing rule of team %s", teamId))
+	diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the default routing rule of team %s", teamId))
+	return nil
+}
+
+func (r *TeamDefaultRoutingRuleResource) updateRoutingRule(ctx context.Context, teamId string, ruleDto *dto.RoutingRuleDto, diagnostics *diag.Diagnostics) {
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/routing-rules/%s", teamId, ruleDto.ID)).
+		Method(httpClient.PATCH).
+		SetBody(*ruleDto).
+		SetBodyParseObject(ruleDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update default routing rule", diagnostics, ctx)
+}
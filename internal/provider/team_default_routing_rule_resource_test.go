@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamDefaultRoutingRuleResource(t *testing.T) {
+	teamName := uuid.NewString()
+	ruleName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_team_default_routing_rule" "example" {
+  team_id = atlassian-operations_team.example.id
+  notify = {
+    type = "escalation"
+    id = atlassian-operations_team.example.default_escalation_id
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_team_default_routing_rule.example", "id", "atlassian-operations_team.example", "default_routing_rule_id"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_team_default_routing_rule.example", "name"),
+					resource.TestCheckResourceAttr("atlassian-operations_team_default_routing_rule.example", "notify.type", "escalation"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_team_default_routing_rule.example", "notify.id", "atlassian-operations_team.example", "default_escalation_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_team_default_routing_rule.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_team_default_routing_rule.example"].Primary.Attributes["team_id"], nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  organization_id = "` + organizationId + `"
+  description = "This is a team created by Terraform"
+  display_name = "` + teamName + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+      account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+
+resource "atlassian-operations_team_default_routing_rule" "example" {
+  team_id = atlassian-operations_team.example.id
+  name = "` + ruleName + `"
+  notify = {
+    type = "schedule"
+    id = atlassian-operations_team.example.default_schedule_id
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_team_default_routing_rule.example", "id", "atlassian-operations_team.example", "default_routing_rule_id"),
+					resource.TestCheckResourceAttr("atlassian-operations_team_default_routing_rule.example", "name", ruleName),
+					resource.TestCheckResourceAttr("atlassian-operations_team_default_routing_rule.example", "notify.type", "schedule"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_team_default_routing_rule.example", "notify.id", "atlassian-operations_team.example", "default_schedule_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &TeamMembershipResource{}
+	_ resource.ResourceWithConfigure   = &TeamMembershipResource{}
+	_ resource.ResourceWithImportState = &TeamMembershipResource{}
+)
+
+// TeamMembershipResource defines the resource implementation for a single member of a team
+type TeamMembershipResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewTeamMembershipResource() resource.Resource {
+	return &TeamMembershipResource{}
+}
+
+// Metadata returns metadata for the resource
+func (r *TeamMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+// Schema defines the schema for the resource
+func (r *TeamMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a single member of a team and their role in the operations team.",
+		Attributes:  schemaAttributes.TeamMembershipResourceAttributes,
+	}
+}
+
+// Configure sets up the resource with provider configuration
+func (r *TeamMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring TeamMembershipResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dto.JsmopsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured TeamMembershipResource")
+}
+
+// Create handles the create operation for the resource
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating TeamMembershipResource")
+
+	var plan dataModels.TeamMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member := dto.TeamMember{AccountId: plan.AccountId.ValueString()}
+
+	memberAddResponse := dto.PublicApiMembershipAddResponse{}
+	httpResp, err := httpClientHelpers.
+		GenerateTeamsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/teams/%s/members/add", plan.OrganizationId.ValueString(), plan.TeamId.ValueString())).
+		Method(httpClient.POST).
+		SetBody(dto.TeamMemberList{Members: []dto.TeamMember{member}}).
+		SetBodyParseObject(&memberAddResponse).
+		Send()
+
+	handleHttpResponse(httpResp, err, "add member to the team", &resp.Diagnostics, ctx)
+	if !resp.Diagnostics.HasError() && len(memberAddResponse.Errors) > 0 {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to add member to the team, got errors: %v", memberAddResponse.Errors))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add member to the team, got errors: %v", memberAddResponse.Errors))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateRole(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		// The member would otherwise be left in the team without being tracked by Terraform
+		tflog.Trace(ctx, "Removing dangling team member")
+		r.removeMemberSilent(plan)
+		return
+	}
+
+	plan.Id = types.StringValue(teamMembershipId(plan.TeamId.ValueString(), plan.AccountId.ValueString(), plan.OrganizationId.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "Created TeamMembershipResource")
+}
+
+// Read handles the read operation for the resource
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataModels.TeamMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Reading TeamMembershipResource")
+
+	members, err := fetchTeamMembers(r.clientConfiguration, state.OrganizationId.ValueString(), state.TeamId.ValueString())
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to fetch members of the team, %s", err.Error()))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch members of the team, %s", err.Error()))
+		return
+	}
+
+	isMember := slices.ContainsFunc(members, func(member dto.TeamMember) bool {
+		return member.AccountId == state.AccountId.ValueString()
+	})
+	if !isMember {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	roleDto := dto.TeamMemberRole{}
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(teamMemberRoleUrl(state.TeamId.ValueString(), state.AccountId.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&roleDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "read the role of the team member", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = types.StringValue(teamMembershipId(state.TeamId.ValueString(), state.AccountId.ValueString(), state.OrganizationId.ValueString()))
+	state.Role = types.StringValue(roleDto.Role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update handles the update operation for the resource
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataModels.TeamMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating TeamMembershipResource")
+
+	// Every other attribute requires replacement, so only the role can change here
+	r.updateRole(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "Updated TeamMembershipResource")
+}
+
+// Delete handles the delete operation for the resource
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataModels.TeamMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting TeamMembershipResource")
+
+	removeMemberResponse := dto.PublicApiMembershipRemoveResponse{}
+	httpResp, err := httpClientHelpers.
+		GenerateTeamsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/teams/%s/members/remove", state.OrganizationId.ValueString(), state.TeamId.ValueString())).
+		Method(httpClient.POST).
+		SetBody(dto.TeamMemberList{Members: []dto.TeamMember{{AccountId: state.AccountId.ValueString()}}}).
+		SetBodyParseObject(&removeMemberResponse).
+		Send()
+
+	// The team may have been deleted together with its members
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return
+	}
+
+	handleHttpResponse(httpResp, err, "remove member from the team", &resp.Diagnostics, ctx)
+	if !resp.Diagnostics.HasError() && len(removeMemberResponse.Errors) > 0 {
+		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to remove member from the team, got errors: %v", removeMemberResponse.Errors))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove member from the team, got errors: %v", removeMemberResponse.Errors))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted TeamMembershipResource")
+}
+
+// ImportState handles importing the state of an existing resource
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team_id,account_id,organization_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[2])...)
+}
+
+func (r *TeamMembershipResource) updateRole(ctx context.Context, model dataModels.TeamMembershipModel, diags *diag.Diagnostics) {
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(teamMemberRoleUrl(model.TeamId.ValueString(), model.AccountId.ValueString())).
+		Method(httpClient.PUT).
+		SetBody(dto.TeamMemberRole{Role: model.Role.ValueString()}).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update the role of the team member", diags, ctx)
+}
+
+func (r *TeamMembershipResource) removeMemberSilent(model dataModels.TeamMembershipModel) {
+	_, _ = httpClientHelpers.
+		GenerateTeamsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/teams/%s/members/remove", model.OrganizationId.ValueString(), model.TeamId.ValueString())).
+		Method(httpClient.POST).
+		SetBody(dto.TeamMemberList{Members: []dto.TeamMember{{AccountId: model.AccountId.ValueString()}}}).
+		Send()
+}
+
+func teamMemberRoleUrl(teamId string, accountId string) string {
+	return fmt.Sprintf("/v1/teams/%s/members/%s/role", teamId, accountId)
+}
+
+// teamMembershipId returns the ID of the membership, which is also its import identifier
+func teamMembershipId(teamId string, accountId string, organizationId string) string {
+	return teamId + "," + accountId + "," + organizationId
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamMemberResource(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+	emailSecondary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_SECONDARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+			if emailSecondary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_SECONDARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+data "atlassian-operations_user" "test2" {
+	email_address = "` + emailSecondary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  manage_members = false
+}
+
+resource "atlassian-operations_team_member" "example" {
+  organization_id = "` + organizationId + `"
+  team_id = atlassian-operations_team.example.id
+  account_id = data.atlassian-operations_user.test2.account_id
+  role = "admin"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_team_member.example", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_team_member.example", "account_id", "data.atlassian-operations_user.test2", "account_id"),
+					resource.TestCheckResourceAttr("atlassian-operations_team_member.example", "role", "admin"),
+					resource.TestCheckResourceAttr("atlassian-operations_team.example", "manage_members", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "atlassian-operations_team_member.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+data "atlassian-operations_user" "test2" {
+	email_address = "` + emailSecondary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  manage_members = false
+}
+
+resource "atlassian-operations_team_member" "example" {
+  organization_id = "` + organizationId + `"
+  team_id = atlassian-operations_team.example.id
+  account_id = data.atlassian-operations_user.test2.account_id
+  role = "user"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_team_member.example", "role", "user"),
+					resource.TestCheckTypeSetElemAttrPair("atlassian-operations_team.example", "member.*.account_id", "data.atlassian-operations_user.test2", "account_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
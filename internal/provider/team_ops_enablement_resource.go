@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &TeamOpsEnablementResource{}
+	_ resource.ResourceWithConfigure   = &TeamOpsEnablementResource{}
+	_ resource.ResourceWithImportState = &TeamOpsEnablementResource{}
+	_ resource.ResourceWithModifyPlan  = &TeamOpsEnablementResource{}
+)
+
+// TeamOpsEnablementResource enables operations for an existing platform team without owning the team itself
+type TeamOpsEnablementResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewTeamOpsEnablementResource() resource.Resource {
+	return &TeamOpsEnablementResource{}
+}
+
+// Metadata returns metadata for the resource
+func (r *TeamOpsEnablementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_ops_enablement"
+}
+
+// Schema defines the schema for the resource
+func (r *TeamOpsEnablementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enable operations for an existing Atlassian platform team. Destroying this resource only removes it from the state; the team and its operations configuration are kept.",
+		Attributes:  schemaAttributes.TeamOpsEnablementResourceAttributes,
+	}
+}
+
+// Configure sets up the resource with provider configuration
+func (r *TeamOpsEnablementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring TeamOpsEnablementResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dto.JsmopsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured TeamOpsEnablementResource")
+}
+
+// Create handles the create operation for the resource
+func (r *TeamOpsEnablementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating TeamOpsEnablementResource")
+
+	var plan dataModels.TeamOpsEnablementModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var adminAccountIds []string
+	resp.Diagnostics.Append(plan.AdminAccountIds.ElementsAs(ctx, &adminAccountIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enableOpsBody := dto.TeamEnableOps{
+		TeamId:          plan.TeamId.ValueString(),
+		AdminAccountIds: adminAccountIds,
+		InviteUsernames: make([]string, 0),
+	}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("/v1/teams/%s/enable-ops", plan.TeamId.ValueString())).
+		Method(httpClient.POST).
+		SetBody(enableOpsBody).
+		Send()
+
+	handleHttpResponse(httpResp, err, "enable Operations for the team", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.TeamId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "Created TeamOpsEnablementResource")
+}
+
+// Read handles the read operation for the resource
+func (r *TeamOpsEnablementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataModels.TeamOpsEnablementModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Reading TeamOpsEnablementResource")
+
+	teamDto := dto.TeamDto{}
+	httpResp, err := httpClientHelpers.
+		GenerateTeamsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/teams/%s", state.OrganizationId.ValueString(), state.TeamId.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&teamDto).
+		Send()
+
+	// Operations can not be disabled, so the enablement only goes away together with the team
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	handleHttpResponse(httpResp, err, "read team", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = types.StringValue(teamDto.TeamId)
+	state.TeamId = types.StringValue(teamDto.TeamId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ModifyPlan rejects changes to the admins, since they are only used when enabling operations. Imported enablements
+// have no admins in the state, so they take over the configured ones.
+func (r *TeamOpsEnablementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state dataModels.TeamOpsEnablementModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.AdminAccountIds.IsNull() || plan.AdminAccountIds.IsUnknown() || state.AdminAccountIds.Equal(plan.AdminAccountIds) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("admin_account_ids"),
+		"Unsupported Attribute Change",
+		"The admins of a team are only set when its operations are enabled, and can not be changed afterwards. "+
+			"Use atlassian-operations_team_member to change the roles of the team members.",
+	)
+}
+
+// Update handles the update operation for the resource
+func (r *TeamOpsEnablementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataModels.TeamOpsEnablementModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the admins can change in place, which ModifyPlan only allows for imported enablements
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete handles the delete operation for the resource
+func (r *TeamOpsEnablementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataModels.TeamOpsEnablementModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The platform team is adopted rather than owned, so it is never deleted
+	tflog.Trace(ctx, "Removed TeamOpsEnablementResource from the state, the team is kept", map[string]interface{}{"teamId": state.TeamId.ValueString()})
+}
+
+// ImportState handles importing the state of an existing resource
+func (r *TeamOpsEnablementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team_id,organization_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[1])...)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// createTestPlatformTeam creates a platform team without operations, which the team resource can not do since it
+// always enables them. Operations can not be disabled, so the team is deleted when the test ends.
+func createTestPlatformTeam(t *testing.T, organizationId string) string {
+	productType := os.Getenv("ATLASSIAN_OPS_PRODUCT_TYPE")
+	if productType == "" {
+		productType = "jira-service-desk"
+	}
+	emailAddress := os.Getenv("ATLASSIAN_OPS_API_EMAIL_ADDRESS")
+	if emailAddress == "" {
+		emailAddress = os.Getenv("ATLASSIAN_OPS_API_USERNAME")
+	}
+
+	clientConfiguration := dto.NewAtlassianOpsProviderModel(
+		productType,
+		os.Getenv("ATLASSIAN_OPS_CLOUD_ID"),
+		os.Getenv("ATLASSIAN_OPS_DOMAIN_NAME"),
+		emailAddress,
+		os.Getenv("ATLASSIAN_OPS_API_TOKEN"),
+		os.Getenv("ATLASSIAN_OPS_API_ORG_ADMIN_TOKEN"),
+		5,
+		15*time.Second,
+		100*time.Second,
+		os.Getenv("ATLASSIAN_OPS_STAGING") == "1",
+	)
+
+	teamDto := dto.TeamDto{
+		Description:    "Platform team of the operations enablement acceptance test",
+		DisplayName:    uuid.NewString(),
+		OrganizationId: organizationId,
+		TeamType:       dto.OPEN,
+	}
+
+	httpResp, err := httpClientHelpers.
+		GenerateTeamsClientRequest(clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("%s/teams/", organizationId)).
+		Method(httpClient.POST).
+		SetBody(teamDto).
+		SetBodyParseObject(&teamDto).
+		Send()
+	if err != nil || httpResp == nil || httpResp.IsError() {
+		t.Fatalf("Unable to create the platform team, got error: %v", err)
+	}
+
+	t.Cleanup(func() {
+		httpResp, err := httpClientHelpers.
+			GenerateTeamsClientRequest(clientConfiguration).
+			JoinBaseUrl(fmt.Sprintf("%s/teams/%s", organizationId, teamDto.TeamId)).
+			Method(httpClient.DELETE).
+			Send()
+		if err != nil || httpResp == nil || httpResp.IsError() {
+			t.Errorf("Unable to delete the platform team %s, got error: %v", teamDto.TeamId, err)
+		}
+	})
+
+	return teamDto.TeamId
+}
+
+func TestAccTeamOpsEnablementResource(t *testing.T) {
+	// The platform team is created before the test case, so the test is skipped here rather than by resource.Test
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	testAccPreCheck(t)
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+	platformTeamId := createTestPlatformTeam(t, organizationId)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team_ops_enablement" "example" {
+  organization_id = "` + organizationId + `"
+  team_id = "` + platformTeamId + `"
+  admin_account_ids = [data.atlassian-operations_user.test1.account_id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_team_ops_enablement.example", "id", platformTeamId),
+					resource.TestCheckResourceAttr("atlassian-operations_team_ops_enablement.example", "team_id", platformTeamId),
+					resource.TestCheckResourceAttr("atlassian-operations_team_ops_enablement.example", "admin_account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("atlassian-operations_team_ops_enablement.example", "admin_account_ids.*", "data.atlassian-operations_user.test1", "account_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "atlassian-operations_team_ops_enablement.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_account_ids"},
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["atlassian-operations_team_ops_enablement.example"].Primary.ID +
+							"," +
+							state.RootModule().Resources["atlassian-operations_team_ops_enablement.example"].Primary.Attributes["organization_id"],
+						nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase, and keeps the platform team until the cleanup
+		},
+	})
+}
@@ -11,17 +11,21 @@ import (
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
 	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"net/url"
+	"slices"
 	"strings"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TeamResource{}
 var _ resource.ResourceWithImportState = &TeamResource{}
+var _ resource.ResourceWithValidateConfig = &TeamResource{}
 
 func NewTeamResource() resource.Resource {
 	return &TeamResource{}
@@ -111,7 +115,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	tflog.Trace(ctx, "Team created")
 	tflog.Trace(ctx, "Fetch auto created members")
 
-	autoAddedMembers, err := r.fetchTeamMembers(teamDto.OrganizationId, teamDto.TeamId)
+	autoAddedMembers, err := fetchTeamMembers(r.clientConfiguration, teamDto.OrganizationId, teamDto.TeamId)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to fetch members for the created team, %s", err.Error()))
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch members for the created team, %s", err.Error()))
@@ -123,6 +127,37 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	addedUsers, removedUsers := diffUsers(membersDto, autoAddedMembers)
+	adminAccountIds := make([]string, 0)
+
+	if data.ManageMembers.ValueBool() {
+		if !data.AdminAccountIds.IsNull() && !data.AdminAccountIds.IsUnknown() {
+			resp.Diagnostics.Append(data.AdminAccountIds.ElementsAs(ctx, &adminAccountIds, false)...)
+		} else {
+			// Without configured admins, the creator, who was added to the team automatically, becomes its admin
+			for _, member := range autoAddedMembers {
+				if slices.ContainsFunc(membersDto, func(configured dto.TeamMember) bool { return configured.AccountId == member.AccountId }) {
+					adminAccountIds = append(adminAccountIds, member.AccountId)
+				}
+			}
+			if len(adminAccountIds) == 0 {
+				resp.Diagnostics.AddAttributeError(path.Root("admin_account_ids"), "Missing Team Admin",
+					"admin_account_ids must be set, since the user the provider authenticates as is not a member of the team")
+			}
+		}
+
+		if resp.Diagnostics.HasError() {
+			tflog.Trace(ctx, "Deleting dangling team resource")
+			r.cleanupTeamSilent(teamDto)
+			return
+		}
+	} else {
+		// The members are managed elsewhere, so keep the automatically added creator as the only member and admin
+		addedUsers, removedUsers = nil, nil
+		membersDto = autoAddedMembers
+		for _, member := range autoAddedMembers {
+			adminAccountIds = append(adminAccountIds, member.AccountId)
+		}
+	}
 
 	if len(addedUsers) > 0 {
 		tflog.Trace(ctx, "Adding users to the team")
@@ -211,7 +246,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	tflog.Trace(ctx, "Enabling Operations for the Team")
 	enableOpsBody := dto.TeamEnableOps{
 		TeamId:          teamDto.TeamId,
-		AdminAccountIds: []string{membersDto[0].AccountId},
+		AdminAccountIds: adminAccountIds,
 		InviteUsernames: make([]string, 0),
 	}
 
@@ -257,26 +292,41 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 	tflog.Trace(ctx, "Enabled Operations for the Team")
 
+	defaultScheduleId, defaultEscalationId, defaultRoutingRuleId := findTeamDefaults(ctx, r.clientConfiguration, teamDto.TeamId, &resp.Diagnostics)
+
 	if data.DeleteDefaultResources.ValueBool() {
 		tflog.Trace(ctx, "Deleting default resources for the team")
 
 		err = findAndUpdateDefaultRoutingRule(teamDto.TeamId, r.clientConfiguration)
 		if err != nil {
 			tflog.Trace(ctx, "Could not find and update default routing rule for team", map[string]interface{}{"teamId": teamDto.TeamId, "error": err.Error()})
+			resp.Diagnostics.AddWarning("Unable to update default routing rule", fmt.Sprintf("The default routing rule of the team could not be set to none, got error: %s", err))
 		}
 
 		err = findAndDeleteDefaultEscalation(teamDto.TeamId, r.clientConfiguration)
 		if err != nil {
 			tflog.Trace(ctx, "Could not find and delete default escalation for team", map[string]interface{}{"teamId": teamDto.TeamId, "error": err.Error()})
+			resp.Diagnostics.AddWarning("Unable to delete default escalation", fmt.Sprintf("The default escalation of the team could not be deleted, got error: %s", err))
+		} else {
+			defaultEscalationId = types.StringNull()
 		}
 
 		err = findAndDeleteDefaultSchedule(teamDto.TeamId, r.clientConfiguration)
 		if err != nil {
 			tflog.Trace(ctx, "Could not find and delete default schedule for team", map[string]interface{}{"teamId": teamDto.TeamId, "error": err.Error()})
+			resp.Diagnostics.AddWarning("Unable to delete default schedule", fmt.Sprintf("The default schedule of the team could not be deleted, got error: %s", err))
+		} else {
+			defaultScheduleId = types.StringNull()
 		}
 	}
 
-	data = TeamDtoToModel(teamDto, membersDto, data.DeleteDefaultResources)
+	data = TeamDtoToModel(teamDto, membersDto, data.DeleteDefaultResources, data.ManageMembers)
+	adminAccountIdsSet, diags := types.SetValueFrom(ctx, types.StringType, adminAccountIds)
+	resp.Diagnostics.Append(diags...)
+	data.AdminAccountIds = adminAccountIdsSet
+	data.DefaultScheduleId = defaultScheduleId
+	data.DefaultEscalationId = defaultEscalationId
+	data.DefaultRoutingRuleId = defaultRoutingRuleId
 
 	tflog.Trace(ctx, "Created the TeamResource")
 
@@ -285,6 +335,44 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	tflog.Trace(ctx, "Saved the TeamResource into Terraform state")
 }
 
+// findTeamDefaults looks up the schedule, escalation and routing rule created together with an operations team.
+// Lookup failures are reported as warnings, since the team itself is usable without knowing its defaults.
+func findTeamDefaults(ctx context.Context, configuration dto.AtlassianOpsProviderModel, teamId string, diagnostics *diag.Diagnostics) (types.String, types.String, types.String) {
+	scheduleId, escalationId, routingRuleId := types.StringNull(), types.StringNull(), types.StringNull()
+	lookupDiagnostics := diag.Diagnostics{}
+
+	newRequest := func() *httpClient.Request {
+		return httpClientHelpers.GenerateJsmOpsClientRequest(configuration)
+	}
+
+	schedules := listAllPages[dto.Schedule](ctx, newRequest, "/v1/schedules", map[string]string{}, "list schedules", &lookupDiagnostics)
+	for _, schedule := range schedules {
+		if strings.EqualFold(schedule.TeamId, teamId) {
+			scheduleId = types.StringValue(schedule.Id)
+			break
+		}
+	}
+
+	escalations := listAllPages[dto.EscalationDto](ctx, newRequest, fmt.Sprintf("/v1/teams/%s/escalations", teamId), map[string]string{}, "list escalations", &lookupDiagnostics)
+	if len(escalations) > 0 {
+		escalationId = types.StringValue(escalations[0].Id)
+	}
+
+	routingRules := listAllPages[dto.RoutingRuleDto](ctx, newRequest, fmt.Sprintf("/v1/teams/%s/routing-rules", teamId), map[string]string{}, "list routing rules", &lookupDiagnostics)
+	for _, rule := range routingRules {
+		if rule.IsDefault {
+			routingRuleId = types.StringValue(rule.ID)
+			break
+		}
+	}
+
+	for _, lookupError := range lookupDiagnostics.Errors() {
+		diagnostics.AddWarning("Unable to look up default resources of the team", lookupError.Detail())
+	}
+
+	return scheduleId, escalationId, routingRuleId
+}
+
 // list schedules using teamId then delete its default schedule
 func findAndDeleteDefaultSchedule(teamId string, configuration dto.AtlassianOpsProviderModel) error {
 	tflog.Trace(context.Background(), "Finding and deleting default schedule for team", map[string]interface{}{"teamId": teamId})
@@ -478,7 +566,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	tflog.Trace(ctx, "Fetching team members")
 
-	memberData, err := r.fetchTeamMembers(data.OrganizationId.ValueString(), data.Id.ValueString())
+	memberData, err := fetchTeamMembers(r.clientConfiguration, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to fetch members for the created team, %s", err.Error()))
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch members for the created team, %s", err.Error()))
@@ -490,7 +578,23 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	tflog.Trace(ctx, "Converting Team Data into Terraform Model")
 
-	data = TeamDtoToModel(teamDto, memberData, data.DeleteDefaultResources)
+	// Imported teams have no value yet, so fall back to the default
+	if data.ManageMembers.IsNull() {
+		data.ManageMembers = types.BoolValue(true)
+	}
+
+	defaultScheduleId, defaultEscalationId, defaultRoutingRuleId := data.DefaultScheduleId, data.DefaultEscalationId, data.DefaultRoutingRuleId
+	if data.DefaultRoutingRuleId.IsNull() {
+		// Imported teams have no defaults in the state yet, so look them up
+		defaultScheduleId, defaultEscalationId, defaultRoutingRuleId = findTeamDefaults(ctx, r.clientConfiguration, data.Id.ValueString(), &resp.Diagnostics)
+	}
+
+	adminAccountIds := data.AdminAccountIds
+	data = TeamDtoToModel(teamDto, memberData, data.DeleteDefaultResources, data.ManageMembers)
+	data.AdminAccountIds = adminAccountIds
+	data.DefaultScheduleId = defaultScheduleId
+	data.DefaultEscalationId = defaultEscalationId
+	data.DefaultRoutingRuleId = defaultRoutingRuleId
 
 	tflog.Trace(ctx, "Read the TeamResource")
 
@@ -558,6 +662,16 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	tflog.Trace(ctx, "Updating the team members")
 	addedUsers, removedUsers := diffUsers(newUsersDto, currentUsersDto)
 
+	if !newData.ManageMembers.ValueBool() {
+		addedUsers, removedUsers = nil, nil
+		newUsersDto, err = fetchTeamMembers(r.clientConfiguration, newData.OrganizationId.ValueString(), newData.Id.ValueString())
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Client Error. Unable to fetch members for the updated team, %s", err.Error()))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch members for the updated team, %s", err.Error()))
+			return
+		}
+	}
+
 	if len(addedUsers) > 0 {
 		tflog.Trace(ctx, "Adding new team members")
 		httpResp, err = httpClientHelpers.
@@ -629,7 +743,16 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
-	newData = TeamDtoToModel(newTeamDto, newUsersDto, newData.DeleteDefaultResources)
+	// The admins are only set on creation, or taken over from the configuration for imported teams
+	adminAccountIds := newData.AdminAccountIds
+	if adminAccountIds.IsUnknown() {
+		adminAccountIds = currentData.AdminAccountIds
+	}
+	newData = TeamDtoToModel(newTeamDto, newUsersDto, newData.DeleteDefaultResources, newData.ManageMembers)
+	newData.AdminAccountIds = adminAccountIds
+	newData.DefaultScheduleId = currentData.DefaultScheduleId
+	newData.DefaultEscalationId = currentData.DefaultEscalationId
+	newData.DefaultRoutingRuleId = currentData.DefaultRoutingRuleId
 
 	tflog.Trace(ctx, "Updated the TeamResource")
 
@@ -681,6 +804,69 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	tflog.Trace(ctx, "Deleted the TeamResource")
 }
 
+func (r *TeamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModels.TeamModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.ManageMembers.IsUnknown() || data.Member.IsUnknown() {
+		return
+	}
+
+	if data.ManageMembers.IsNull() || data.ManageMembers.ValueBool() {
+		if data.Member.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("member"),
+				"Missing Attribute Configuration",
+				"The member attribute must be set when manage_members is true.",
+			)
+		}
+		validateTeamAdmins(ctx, data, &resp.Diagnostics)
+	} else {
+		if !data.Member.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("member"),
+				"Invalid Attribute Combination",
+				"The member attribute must not be set when manage_members is false. Manage the members with atlassian-operations_team_member resources instead.",
+			)
+		}
+		if !data.AdminAccountIds.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("admin_account_ids"),
+				"Invalid Attribute Combination",
+				"The admin_account_ids attribute must not be set when manage_members is false, since the creator is then the only member and admin of the team.",
+			)
+		}
+	}
+}
+
+// validateTeamAdmins checks that every configured admin is one of the configured members of the team
+func validateTeamAdmins(ctx context.Context, data dataModels.TeamModel, diags *diag.Diagnostics) {
+	if data.AdminAccountIds.IsNull() || data.AdminAccountIds.IsUnknown() || data.Member.IsNull() {
+		return
+	}
+
+	adminAccountIds := make([]types.String, 0)
+	members := make([]dataModels.TeamMemberModel, 0)
+	diags.Append(data.AdminAccountIds.ElementsAs(ctx, &adminAccountIds, false)...)
+	diags.Append(data.Member.ElementsAs(ctx, &members, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	for _, adminAccountId := range adminAccountIds {
+		if adminAccountId.IsUnknown() {
+			continue
+		}
+		isMember := slices.ContainsFunc(members, func(member dataModels.TeamMemberModel) bool {
+			return member.AccountId.IsUnknown() || member.AccountId.Equal(adminAccountId)
+		})
+		if !isMember {
+			diags.AddAttributeError(path.Root("admin_account_ids"), "Invalid Team Admin",
+				fmt.Sprintf("The admin %s must also be a member of the team.", adminAccountId.ValueString()))
+		}
+	}
+}
+
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, ",")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
@@ -694,7 +880,7 @@ func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[1])...)
 }
 
-func (r *TeamResource) fetchTeamMembers(organizationId string, teamId string) ([]dto.TeamMember, error) {
+func fetchTeamMembers(configuration dto.AtlassianOpsProviderModel, organizationId string, teamId string) ([]dto.TeamMember, error) {
 	var members []dto.TeamMember
 
 	doneLooping := false
@@ -703,7 +889,7 @@ func (r *TeamResource) fetchTeamMembers(organizationId string, teamId string) ([
 		response := dto.TeamMemberListResponse{}
 
 		httpResp, err := httpClientHelpers.
-			GenerateTeamsClientRequest(r.clientConfiguration).
+			GenerateTeamsClientRequest(configuration).
 			JoinBaseUrl(fmt.Sprintf("/%s/teams/%s/members", organizationId, teamId)).
 			Method("POST").
 			SetBody(request).
@@ -62,6 +62,10 @@ resource "atlassian-operations_team" "example" {
 					resource.TestCheckResourceAttr("atlassian-operations_team.example", "user_permissions.delete_team", "true"),
 					resource.TestCheckResourceAttr("atlassian-operations_team.example", "member.#", "1"),
 					resource.TestCheckTypeSetElemAttrPair("atlassian-operations_team.example", "member.*.account_id", "data.atlassian-operations_user.test1", "account_id"),
+					resource.TestCheckResourceAttr("atlassian-operations_team.example", "admin_account_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_team.example", "default_schedule_id"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_team.example", "default_escalation_id"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_team.example", "default_routing_rule_id"),
 				),
 			},
 			// ImportState testing
@@ -69,7 +73,7 @@ resource "atlassian-operations_team" "example" {
 				ResourceName:            "atlassian-operations_team.example",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"delete_default_resources"},
+				ImportStateVerifyIgnore: []string{"delete_default_resources", "admin_account_ids"},
 				ImportStateIdFunc: func(state *terraform.State) (string, error) {
 					return state.RootModule().Resources["atlassian-operations_team.example"].Primary.ID +
 							"," +
@@ -155,3 +159,64 @@ resource "atlassian-operations_team" "example" {
 		},
 	})
 }
+
+func TestAccTeamResourceAdmins(t *testing.T) {
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+	emailSecondary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_SECONDARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+			if emailSecondary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_SECONDARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+data "atlassian-operations_user" "test2" {
+	email_address = "` + emailSecondary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    },
+    {
+       account_id = data.atlassian-operations_user.test2.account_id
+    }
+  ]
+  admin_account_ids = [data.atlassian-operations_user.test2.account_id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_team.example", "member.#", "2"),
+					resource.TestCheckResourceAttr("atlassian-operations_team.example", "admin_account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("atlassian-operations_team.example", "admin_account_ids.*", "data.atlassian-operations_user.test2", "account_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
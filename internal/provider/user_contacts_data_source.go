@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &UserContactsDataSource{}
+	_ datasource.DataSourceWithConfigure = &UserContactsDataSource{}
+)
+
+func NewUserContactsDataSource() datasource.DataSource {
+	return &UserContactsDataSource{}
+}
+
+// UserContactsDataSource defines the data source implementation.
+type UserContactsDataSource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (d *UserContactsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_contacts"
+}
+
+func (d *UserContactsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "User contacts data source",
+		Attributes:          schemaAttributes.UserContactsDataSourceAttributes,
+	}
+}
+
+func (d *UserContactsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring user_contacts_data_source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Cannot configure user_contacts_data_source."+
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *httpClient.HttpClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clientConfiguration = client
+	tflog.Trace(ctx, "Configured user_contacts_data_source")
+}
+
+func (d *UserContactsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataModels.UserContactsDataSourceModel
+
+	tflog.Trace(ctx, "Reading user contacts data source from JSM OPS API")
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Unable to read user contacts configuration. Configuration data provided is invalid.")
+		return
+	}
+
+	tflog.Trace(ctx, "Sending HTTP requests to JSM OPS API")
+
+	contacts := listAllPages[dto.UserContactDataReadResponseDto](
+		ctx,
+		func() *httpClient.Request {
+			return httpClientHelpers.GenerateJsmOpsClientRequest(d.clientConfiguration)
+		},
+		"/v1/users/contacts",
+		map[string]string{},
+		"list user contacts",
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contactValues := make([]attr.Value, 0, len(contacts))
+	for i := range contacts {
+		if !data.Method.IsNull() && contacts[i].Method != data.Method.ValueString() {
+			continue
+		}
+		if !data.Enabled.IsNull() && contacts[i].Status.Enabled != data.Enabled.ValueBool() {
+			continue
+		}
+		contactValues = append(contactValues, UserContactReadDtoToModel(&contacts[i]).AsValue())
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("HTTP requests to JSM OPS API Succeeded. Found %d user contacts", len(contactValues)))
+	data.Contacts = types.ListValueMust(types.ObjectType{AttrTypes: dataModels.UserContactModelMap}, contactValues)
+
+	tflog.Trace(ctx, "Successfully read user contacts data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
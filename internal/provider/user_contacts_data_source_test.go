@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserContactsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "atlassian-operations_user_contact" "example" {
+  method  = "email"
+  to      = "kagan+contacts@opsgenie.com"
+  enabled = false
+}
+
+data "atlassian-operations_user_contacts" "test" {
+	depends_on = ["atlassian-operations_user_contact.example"]
+	method = "email"
+	enabled = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the data source
+					// Verify the created contact is listed
+					resource.TestCheckTypeSetElemNestedAttrs("data.atlassian-operations_user_contacts.test", "contacts.*", map[string]string{
+						"method":  "email",
+						"to":      "kagan+contacts@opsgenie.com",
+						"enabled": "false",
+					}),
+				),
+			},
+		},
+	})
+}
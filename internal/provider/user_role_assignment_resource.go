@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultUserRole is the role every user has when no other role is assigned to them
+const defaultUserRole = "user"
+
+var (
+	_ resource.Resource                = &UserRoleAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &UserRoleAssignmentResource{}
+	_ resource.ResourceWithImportState = &UserRoleAssignmentResource{}
+)
+
+// UserRoleAssignmentResource assigns a built-in or custom role to a user
+type UserRoleAssignmentResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func NewUserRoleAssignmentResource() resource.Resource {
+	return &UserRoleAssignmentResource{}
+}
+
+// Metadata returns metadata for the resource
+func (r *UserRoleAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_role_assignment"
+}
+
+// Schema defines the schema for the resource
+func (r *UserRoleAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assign a built-in or custom role to a user. Destroying this resource gives the user the role they had before back, or the 'user' role for imported assignments.",
+		Attributes:  schemaAttributes.UserRoleAssignmentResourceAttributes,
+	}
+}
+
+// Configure sets up the resource with provider configuration
+func (r *UserRoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring UserRoleAssignmentResource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dto.JsmopsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+	tflog.Trace(ctx, "Configured UserRoleAssignmentResource")
+}
+
+// Create assigns the role to the user
+func (r *UserRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating UserRoleAssignmentResource")
+
+	var plan dataModels.UserRoleAssignmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The role the user had before is given back when the resource is destroyed
+	previousRoleDto := dto.UserRoleDto{}
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(userRoleUrl(plan.AccountID.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&previousRoleDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "read the role of the user", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateRole(ctx, plan.AccountID.ValueString(), plan.Role.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.AccountID
+	plan.PreviousRole = types.StringValue(previousRoleDto.Role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "Created UserRoleAssignmentResource")
+}
+
+// Read handles the read operation for the resource
+func (r *UserRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataModels.UserRoleAssignmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Reading UserRoleAssignmentResource")
+
+	roleDto := dto.UserRoleDto{}
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(userRoleUrl(state.AccountID.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&roleDto).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	handleHttpResponse(httpResp, err, "read the role of the user", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A role changed out of band shows up as a difference to the configured one
+	state.ID = state.AccountID
+	state.Role = types.StringValue(roleDto.Role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update assigns the new role to the user
+func (r *UserRoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataModels.UserRoleAssignmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating UserRoleAssignmentResource")
+
+	r.updateRole(ctx, plan.AccountID.ValueString(), plan.Role.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "Updated UserRoleAssignmentResource")
+}
+
+// Delete gives the user the role they had before the assignment back, since every user has a role. Imported
+// assignments do not know the previous role, so the user gets the default role.
+func (r *UserRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataModels.UserRoleAssignmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting UserRoleAssignmentResource")
+
+	role := defaultUserRole
+	if !state.PreviousRole.IsNull() && state.PreviousRole.ValueString() != "" {
+		role = state.PreviousRole.ValueString()
+	}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(userRoleUrl(state.AccountID.ValueString())).
+		Method(httpClient.PUT).
+		SetBody(dto.UserRoleDto{Role: role}).
+		Send()
+
+	// The user may have been removed in the meantime
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		return
+	}
+
+	handleHttpResponse(httpResp, err, "reset the role of the user", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted UserRoleAssignmentResource")
+}
+
+// ImportState imports the role assignment of a user by the account ID
+func (r *UserRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("account_id"), req, resp)
+}
+
+func (r *UserRoleAssignmentResource) updateRole(ctx context.Context, accountId string, role string, diags *diag.Diagnostics) {
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(userRoleUrl(accountId)).
+		Method(httpClient.PUT).
+		SetBody(dto.UserRoleDto{Role: role}).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update the role of the user", diags, ctx)
+}
+
+func userRoleUrl(accountId string) string {
+	return fmt.Sprintf("/v1/users/%s/role", accountId)
+}
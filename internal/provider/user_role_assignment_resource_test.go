@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserRoleAssignmentResource(t *testing.T) {
+	roleName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailSecondary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_SECONDARY")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailSecondary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_SECONDARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test2" {
+	email_address = "` + emailSecondary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_custom_role" "example" {
+  name = "` + roleName + `"
+  granted_rights = [
+    "alert-acknowledge",
+    "alert-close"
+  ]
+  disallowed_rights = [
+    "alert-delete"
+  ]
+}
+
+resource "atlassian-operations_user_role_assignment" "example" {
+  account_id = data.atlassian-operations_user.test2.account_id
+  role       = "admin"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_user_role_assignment.example", "account_id", "data.atlassian-operations_user.test2", "account_id"),
+					resource.TestCheckResourceAttrPair("atlassian-operations_user_role_assignment.example", "id", "data.atlassian-operations_user.test2", "account_id"),
+					resource.TestCheckResourceAttr("atlassian-operations_user_role_assignment.example", "role", "admin"),
+					resource.TestCheckResourceAttrSet("atlassian-operations_user_role_assignment.example", "previous_role"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "atlassian-operations_user_role_assignment.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"previous_role"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+data "atlassian-operations_user" "test2" {
+	email_address = "` + emailSecondary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_custom_role" "example" {
+  name = "` + roleName + `"
+  granted_rights = [
+    "alert-acknowledge",
+    "alert-close"
+  ]
+  disallowed_rights = [
+    "alert-delete"
+  ]
+}
+
+resource "atlassian-operations_user_role_assignment" "example" {
+  account_id = data.atlassian-operations_user.test2.account_id
+  role       = atlassian-operations_custom_role.example.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian-operations_user_role_assignment.example", "role", "atlassian-operations_custom_role.example", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/dto"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/httpClient/httpClientHelpers"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/dataModels"
+	"github.com/atlassian/terraform-provider-atlassian-operations/internal/provider/schemaAttributes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WebhookIntegrationResource{}
+var _ resource.ResourceWithImportState = &WebhookIntegrationResource{}
+
+func NewWebhookIntegrationResource() resource.Resource {
+	return &WebhookIntegrationResource{}
+}
+
+// WebhookIntegrationResource defines the resource implementation.
+type WebhookIntegrationResource struct {
+	clientConfiguration dto.AtlassianOpsProviderModel
+}
+
+func (r *WebhookIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_integration"
+}
+
+func (r *WebhookIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: schemaAttributes.WebhookIntegrationResourceAttributes,
+	}
+}
+
+func (r *WebhookIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "Configuring WebhookIntegrationResource")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(dto.AtlassianOpsProviderModel)
+
+	if !ok {
+		tflog.Error(ctx, "Unexpected Resource Configure Type")
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JsmOpsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientConfiguration = client
+
+	tflog.Trace(ctx, "Configured WebhookIntegrationResource")
+}
+
+func (r *WebhookIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "Creating the WebhookIntegrationResource")
+
+	var data dataModels.WebhookIntegrationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookIntegrationModelToDto := WebhookIntegrationModelToDto(ctx, data)
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl("v1/integrations").
+		Method(httpClient.POST).
+		SetBody(webhookIntegrationModelToDto).
+		SetBodyParseObject(&webhookIntegrationModelToDto).
+		Send()
+
+	handleHttpResponse(httpResp, err, "create webhook integration", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = WebhookIntegrationDtoToModel(webhookIntegrationModelToDto)
+
+	tflog.Trace(ctx, "Created the WebhookIntegrationResource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "Saved the WebhookIntegrationResource into Terraform state")
+}
+
+func (r *WebhookIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataModels.WebhookIntegrationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Reading the WebhookIntegrationResource")
+
+	webhookIntegration := dto.WebhookIntegration{}
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.GET).
+		SetBodyParseObject(&webhookIntegration).
+		Send()
+
+	if httpResp != nil && httpResp.GetStatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	handleHttpResponse(httpResp, err, "read webhook integration", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if webhookIntegration.Type != "Webhook" {
+		resp.Diagnostics.AddError(
+			"Unexpected Integration Type",
+			fmt.Sprintf("Integration %s is of type '%s', expected 'Webhook'", data.Id.ValueString(), webhookIntegration.Type),
+		)
+		return
+	}
+
+	data = WebhookIntegrationDtoToModel(webhookIntegration)
+
+	tflog.Trace(ctx, "Read the WebhookIntegrationResource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "Saved the WebhookIntegrationResource into Terraform state")
+}
+
+func (r *WebhookIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data dataModels.WebhookIntegrationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating the WebhookIntegrationResource")
+
+	webhook := WebhookIntegrationModelToDto(ctx, data)
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.PATCH).
+		SetBody(webhook).
+		SetBodyParseObject(&webhook).
+		Send()
+
+	handleHttpResponse(httpResp, err, "update webhook integration", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = WebhookIntegrationDtoToModel(webhook)
+
+	tflog.Trace(ctx, "Updated the WebhookIntegrationResource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "Saved the WebhookIntegrationResource into Terraform state")
+}
+
+func (r *WebhookIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dataModels.WebhookIntegrationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting the WebhookIntegrationResource")
+
+	httpResp, err := httpClientHelpers.
+		GenerateJsmOpsClientRequest(r.clientConfiguration).
+		JoinBaseUrl(fmt.Sprintf("v1/integrations/%s", data.Id.ValueString())).
+		Method(httpClient.DELETE).
+		Send()
+
+	handleHttpResponse(httpResp, err, "delete webhook integration", &resp.Diagnostics, ctx)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted the WebhookIntegrationResource")
+}
+
+func (r *WebhookIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhookIntegrationResource(t *testing.T) {
+	webhookIntegrationName := uuid.NewString()
+	webhookIntegrationUpdateName := uuid.NewString()
+
+	teamName := uuid.NewString()
+
+	organizationId := os.Getenv("ATLASSIAN_ACCTEST_ORGANIZATION_ID")
+	emailPrimary := os.Getenv("ATLASSIAN_ACCTEST_EMAIL_PRIMARY")
+
+	teamConfig := `
+data "atlassian-operations_user" "test1" {
+	email_address = "` + emailPrimary + `"
+	organization_id = "` + organizationId + `"
+}
+
+resource "atlassian-operations_team" "example" {
+  display_name = "` + teamName + `"
+  description = "team description"
+  organization_id = "` + organizationId + `"
+  team_type = "MEMBER_INVITE"
+  member = [
+    {
+       account_id = data.atlassian-operations_user.test1.account_id
+    }
+  ]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			if organizationId == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_ORGANIZATION_ID must be set for acceptance tests")
+			}
+			if emailPrimary == "" {
+				t.Fatal("ATLASSIAN_ACCTEST_EMAIL_PRIMARY must be set for acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Plan-time validation testing
+			{
+				PlanOnly: true,
+				Config: providerConfig + `
+resource "atlassian-operations_webhook_integration" "invalid" {
+  name = "` + webhookIntegrationName + `"
+  type_specific_properties = {
+    url = "not-a-url"
+    headers = {
+      "Invalid Header" = "value"
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)must be an absolute http or https URL.*must be a valid HTTP header name`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + teamConfig + `
+resource "atlassian-operations_webhook_integration" "example" {
+  name    = "` + webhookIntegrationName + `"
+  team_id = atlassian-operations_team.example.id
+  type_specific_properties = {
+    url = "https://example.com/hooks/alerts"
+    headers = {
+      "X-Api-Key" = "secret"
+    }
+    add_alert_details = false
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "name", webhookIntegrationName),
+					resource.TestCheckResourceAttrPair("atlassian-operations_webhook_integration.example", "team_id", "atlassian-operations_team.example", "id"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "enabled", "true"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "type_specific_properties.url", "https://example.com/hooks/alerts"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "type_specific_properties.headers.%", "1"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "type_specific_properties.headers.X-Api-Key", "secret"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "type_specific_properties.add_alert_description", "true"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "type_specific_properties.add_alert_details", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "atlassian-operations_webhook_integration.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"directions.#", "domains.#", "domains.0", "directions.0"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + teamConfig + `
+resource "atlassian-operations_webhook_integration" "example" {
+  name    = "` + webhookIntegrationUpdateName + `"
+  team_id = atlassian-operations_team.example.id
+  enabled = false
+  type_specific_properties = {
+    url = "https://example.com/hooks/alerts-updated"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "name", webhookIntegrationUpdateName),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "enabled", "false"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "type_specific_properties.url", "https://example.com/hooks/alerts-updated"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "type_specific_properties.headers.%", "0"),
+					resource.TestCheckResourceAttr("atlassian-operations_webhook_integration.example", "type_specific_properties.add_alert_details", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}